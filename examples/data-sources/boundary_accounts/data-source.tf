@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The account of jeff, created by the OIDC auth method when he first logged in
+data "boundary_accounts" "jeff" {
+  auth_method_id = "amoidc_1234567890"
+  filter         = "\"/item/attributes/email\" == \"jeff@example.com\""
+}
+
+resource "boundary_user" "jeff" {
+  name        = "jeff"
+  scope_id    = "o_1234567890"
+  account_ids = data.boundary_accounts.jeff.items[*].id
+}
@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The alias another team registered for the production database
+data "boundary_aliases" "database" {
+  filter = "\"/item/value\" == \"db.prod.example.com\""
+}
+
+resource "boundary_alias_target" "database" {
+  count = length(data.boundary_aliases.database.items) == 0 ? 1 : 0
+
+  name           = "prod_database"
+  value          = "db.prod.example.com"
+  destination_id = boundary_target.database.id
+}
@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The password auth method of an org
+data "boundary_auth_methods" "password" {
+  scope_id = "o_1234567890"
+  filter   = "\"/item/type\" == \"password\""
+}
+
+resource "boundary_account_password" "jeff" {
+  auth_method_id = one(data.boundary_auth_methods.password.items).id
+  type           = "password"
+  login_name     = "jeff"
+  password       = "$uper$ecure"
+}
@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The credential library named "postgres-readonly" of a Vault credential store
+data "boundary_credential_libraries" "postgres" {
+  credential_store_id = "csvlt_1234567890"
+  filter              = "\"/item/name\" == \"postgres-readonly\""
+}
+
+resource "boundary_target" "postgres" {
+  name                           = "postgres"
+  type                           = "tcp"
+  default_port                   = 5432
+  scope_id                       = "p_1234567890"
+  address                        = "postgres.example.com"
+  brokered_credential_source_ids = data.boundary_credential_libraries.postgres.items[*].id
+}
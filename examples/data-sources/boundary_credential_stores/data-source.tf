@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The Vault credential store managed by the security team
+data "boundary_credential_stores" "vault" {
+  scope_id = "p_1234567890"
+  filter   = "\"/item/type\" == \"vault\" and \"/item/name\" == \"security\""
+}
+
+resource "boundary_credential_library_vault" "db" {
+  name                = "db"
+  credential_store_id = one(data.boundary_credential_stores.vault.items).id
+  path                = "database/creds/readonly"
+  http_method         = "GET"
+}
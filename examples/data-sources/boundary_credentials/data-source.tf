@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The SSH private keys of a static credential store
+data "boundary_credentials" "ssh" {
+  credential_store_id = "csst_1234567890"
+  filter              = "\"/item/type\" == \"ssh_private_key\" and \"/item/attributes/username\" == \"ubuntu\""
+}
+
+resource "boundary_target" "ssh" {
+  name                                       = "ssh"
+  type                                       = "ssh"
+  default_port                               = 22
+  scope_id                                   = "p_1234567890"
+  address                                    = "10.0.0.1"
+  injected_application_credential_source_ids = data.boundary_credentials.ssh.items[*].id
+}
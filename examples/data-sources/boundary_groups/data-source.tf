@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The group named "ops" created outside of Terraform
+data "boundary_groups" "ops" {
+  scope_id = "o_1234567890"
+  filter   = "\"/item/name\" == \"ops\""
+}
+
+resource "boundary_role" "ops" {
+  name          = "ops"
+  scope_id      = "o_1234567890"
+  principal_ids = data.boundary_groups.ops.items[*].id
+  grant_strings = ["ids=*;type=*;actions=read"]
+}
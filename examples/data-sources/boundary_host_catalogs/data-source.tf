@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The AWS host catalogs of a project
+data "boundary_host_catalogs" "aws" {
+  scope_id = "p_1234567890"
+  filter   = "\"/item/plugin/name\" == \"aws\""
+}
+
+resource "boundary_host_set_plugin" "web" {
+  name            = "web"
+  host_catalog_id = one(data.boundary_host_catalogs.aws.items).id
+  attributes_json = jsonencode({ "filters" = ["tag:service-type=web"] })
+}
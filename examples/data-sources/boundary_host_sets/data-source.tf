@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The host sets of a catalog owned by another team
+data "boundary_host_sets" "web" {
+  host_catalog_id = "hcst_1234567890"
+  filter          = "\"/item/name\" matches \"web-.*\""
+}
+
+resource "boundary_target" "web" {
+  name            = "web"
+  type            = "tcp"
+  default_port    = 443
+  scope_id        = "p_1234567890"
+  host_source_ids = data.boundary_host_sets.web.items[*].id
+}
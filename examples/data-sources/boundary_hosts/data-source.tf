@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The hosts discovered by an AWS host catalog
+data "boundary_hosts" "aws" {
+  host_catalog_id = "hcplg_1234567890"
+}
+
+# The EC2 instance IDs and private IP addresses of the hosts
+output "instances" {
+  value = { for h in data.boundary_hosts.aws.items : h.external_id => h.ip_addresses }
+}
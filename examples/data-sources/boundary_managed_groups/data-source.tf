@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The managed groups of an LDAP auth method
+data "boundary_managed_groups" "ldap" {
+  auth_method_id = "amldap_1234567890"
+}
+
+# The accounts currently in each managed group
+output "managed_group_members" {
+  value = { for g in data.boundary_managed_groups.ldap.items : g.name => g.member_ids }
+}
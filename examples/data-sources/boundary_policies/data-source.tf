@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The org-wide storage policy managed by the compliance team
+data "boundary_policies" "global" {
+  scope_id = "global"
+  filter   = "\"/item/name\" == \"org-retention\""
+}
+
+resource "boundary_scope_policy_attachment" "org" {
+  scope_id  = "o_1234567890"
+  policy_id = one(data.boundary_policies.global.items).id
+}
@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The role named "auditors" created outside of Terraform
+data "boundary_roles" "auditors" {
+  scope_id = "o_1234567890"
+  filter   = "\"/item/name\" == \"auditors\""
+}
+
+# Add a user to the role, leaving its other principals alone
+resource "boundary_role_principal" "alice" {
+  role_id      = one(data.boundary_roles.auditors.items).id
+  principal_id = "u_1234567890"
+}
@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The projects of an org
+data "boundary_scopes" "projects" {
+  scope_id = "o_1234567890"
+}
+
+# A host catalog in every project of the org
+resource "boundary_host_catalog_static" "default" {
+  for_each = { for s in data.boundary_scopes.projects.items : s.name => s.id }
+
+  name     = "default"
+  scope_id = each.value
+}
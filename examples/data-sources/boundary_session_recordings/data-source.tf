@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The recordings of the sessions of a target over the last day
+resource "time_offset" "yesterday" {
+  offset_days = -1
+}
+
+data "boundary_session_recordings" "postgres" {
+  scope_id      = "global"
+  recursive     = true
+  target_id     = "ttcp_1234567890"
+  started_after = time_offset.yesterday.rfc3339
+}
+
+output "postgres_recordings" {
+  value = { for r in data.boundary_session_recordings.postgres.items : r.id => r.state }
+}
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The active sessions of a target
+data "boundary_sessions" "postgres" {
+  scope_id  = "p_1234567890"
+  target_id = "ttcp_1234567890"
+  status    = "active"
+}
+
+check "no_active_sessions" {
+  assert {
+    condition     = length(data.boundary_sessions.postgres.items) == 0
+    error_message = "The target still has active sessions."
+  }
+}
@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The storage bucket the platform team manages for the org
+data "boundary_storage_buckets" "recordings" {
+  scope_id = "o_1234567890"
+  filter   = "\"/item/name\" == \"recordings\""
+}
+
+resource "boundary_target" "ssh" {
+  name                     = "ssh"
+  type                     = "ssh"
+  scope_id                 = "p_1234567890"
+  default_port             = 22
+  enable_session_recording = true
+  storage_bucket_id        = one(data.boundary_storage_buckets.recordings.items).id
+}
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The target named "postgres" of the project "databases" of the org "prod"
+data "boundary_target" "postgres" {
+  name         = "postgres"
+  org_name     = "prod"
+  project_name = "databases"
+}
+
+resource "boundary_role" "dba" {
+  name          = "dba"
+  scope_id      = data.boundary_target.postgres.scope_id
+  principal_ids = ["u_1234567890"]
+  grant_strings = ["ids=${data.boundary_target.postgres.id};actions=read,authorize-session"]
+}
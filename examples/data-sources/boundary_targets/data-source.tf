@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# All the targets of the projects of an org whose name starts with "prod-"
+data "boundary_targets" "prod" {
+  scope_id  = "o_1234567890"
+  recursive = true
+  filter    = "\"/item/name\" matches \"prod-.*\""
+}
+
+output "prod_target_ids" {
+  value = data.boundary_targets.prod.items[*].id
+}
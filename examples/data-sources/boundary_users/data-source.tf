@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The users of an org whose primary account has an example.com email
+data "boundary_users" "example" {
+  scope_id = "o_1234567890"
+  filter   = "\"/item/email\" matches \".*@example.com\""
+}
+
+resource "boundary_role" "readonly" {
+  name          = "readonly"
+  scope_id      = "o_1234567890"
+  principal_ids = data.boundary_users.example.items[*].id
+  grant_strings = ["ids=*;type=*;actions=read"]
+}
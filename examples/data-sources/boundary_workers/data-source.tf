@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The workers the worker filter of the database targets selects
+data "boundary_workers" "database" {
+  tags = {
+    region = "us-east-1"
+    type   = "database"
+  }
+}
+
+check "database_workers" {
+  assert {
+    condition     = length(data.boundary_workers.database.items) >= 2
+    error_message = "The database targets need at least two workers."
+  }
+}
@@ -0,0 +1,6 @@
+provider "boundary" {
+  cluster_id                      = "8f8c2e4e-2bb7-4a2b-b2c5-3e1d1b3d9c51" # changeme
+  auth_method_id                  = "ampw_1234567890"                      # changeme
+  password_auth_method_login_name = "myuser"                               # changeme
+  password_auth_method_password   = "passpass"                             # changeme
+}
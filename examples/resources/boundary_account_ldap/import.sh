@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_account_ldap.foo <my-id>
\ No newline at end of file
@@ -20,3 +20,13 @@ resource "boundary_account_password" "jeff" {
   login_name     = "jeff"
   password       = "$uper$ecure"
 }
+
+# The provider generates the password, it is available in the
+# generated_password attribute
+resource "boundary_account_password" "susmitha" {
+  auth_method_id           = boundary_auth_method.password.id
+  type                     = "password"
+  login_name               = "susmitha"
+  generate_password        = true
+  generate_password_length = 24
+}
@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_target" "ssh" {
+  name         = "ssh"
+  type         = "tcp"
+  default_port = "22"
+  scope_id     = boundary_scope.project.id
+}
+
+resource "boundary_alias_target" "example" {
+  name           = "example_alias"
+  description    = "Connect with `boundary connect ssh ssh.example.com`"
+  value          = "ssh.example.com"
+  destination_id = boundary_target.ssh.id
+}
@@ -71,3 +71,12 @@ resource "boundary_credential_library_vault" "quz" {
     username_attribute               = "alternative_username_label"
   }
 }
+
+resource "boundary_credential_library_vault" "json" {
+  name                = "json"
+  description         = "vault json credential"
+  credential_store_id = boundary_credential_store_vault.foo.id
+  path                = "my/secret/json" # change to Vault backend path
+  http_method         = "GET"
+  credential_type     = "json"
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_credential_library_vault_ssh_certificate.foo <my-id>
\ No newline at end of file
@@ -30,3 +30,12 @@ resource "boundary_credential_ssh_private_key" "example" {
   private_key            = file("~/.ssh/id_rsa") # change to valid SSH Private Key
   private_key_passphrase = "optional-passphrase" # change to the passphrase of the Private Key if required
 }
+
+# The provider generates the key pair, distribute the public_key attribute
+# to the targets
+resource "boundary_credential_ssh_private_key" "generated" {
+  name                = "example_generated_ssh_private_key"
+  credential_store_id = boundary_credential_store_static.example.id
+  username            = "my-username"
+  generate_key_type   = "ed25519"
+}
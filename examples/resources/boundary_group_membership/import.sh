@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_group_membership.foo "<group_id>:<user_id>"
\ No newline at end of file
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_group" "engineering" {
+  name        = "engineering"
+  description = "A group several teams add their users to"
+  scope_id    = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [member_ids]
+  }
+}
+
+resource "boundary_user" "foo" {
+  name     = "foo"
+  scope_id = boundary_scope.org.id
+}
+
+resource "boundary_group_membership" "foo" {
+  group_id = boundary_group.engineering.id
+  user_id  = boundary_user.foo.id
+}
@@ -36,6 +36,24 @@ resource "boundary_host_catalog_plugin" "aws_example" {
   })
 }
 
+# The aws catalog can also assume an IAM role with STS, using the credentials of
+# the workers instead of access keys stored in Terraform.
+resource "boundary_host_catalog_plugin" "aws_assume_role_example" {
+  name        = "My aws assume role catalog"
+  description = "My aws host catalog assuming a role!"
+  scope_id    = boundary_scope.project.id
+  plugin_name = "aws"
+
+  attributes_json = jsonencode({
+    "region"                      = "us-east-1",
+    "disable_credential_rotation" = true,
+    "role_arn"                    = "arn:aws:iam::123456789012:role/boundary-host-catalog",
+    "role_external_id"            = "AWS_ROLE_EXTERNAL_ID",
+    "role_session_name"           = "boundary",
+    "role_tags"                   = { "team" = "ops" }
+  })
+}
+
 # For more information about the azure plugin, please visit here:
 # https://github.com/hashicorp/boundary-plugin-host-azure
 #
@@ -61,3 +79,63 @@ resource "boundary_host_catalog_plugin" "azure_example" {
     "secret_value" = "ARM_CLIENT_SECRET"
   })
 }
+
+# When the workers run in azure, the catalog can authenticate with their managed
+# identity instead of the client secret of an ad application.
+resource "boundary_host_catalog_plugin" "azure_managed_identity_example" {
+  name        = "My azure managed identity catalog"
+  description = "My azure host catalog without a client secret!"
+  scope_id    = boundary_scope.project.id
+  plugin_name = "azure"
+
+  attributes_json = jsonencode({
+    "disable_credential_rotation" = true,
+    "tenant_id"                   = "ARM_TENANT_ID",
+    "subscription_id"             = "ARM_SUBSCRIPTION_ID"
+  })
+}
+
+# For more information about the gcp plugin, please visit here:
+# https://github.com/hashicorp/boundary-plugin-gcp
+#
+# For more information about gcp service accounts, please visit here:
+# https://developer.hashicorp.com/boundary/docs/concepts/host-discovery/gcp
+resource "boundary_host_catalog_plugin" "gcp_example" {
+  name        = "My gcp catalog"
+  description = "My third host catalog!"
+  scope_id    = boundary_scope.project.id
+  plugin_name = "gcp"
+
+  # the attributes below must match a gcp service account with the
+  # compute.instances.list permission
+  attributes_json = jsonencode({
+    "disable_credential_rotation" = true,
+    "project_id"                  = "GCP_PROJECT_ID",
+    "zone"                        = "us-central1-a",
+    "client_email"                = "GCP_SERVICE_ACCOUNT_EMAIL"
+  })
+
+  # recommended to pass in gcp secrets using a file() or using environment variables
+  # the secrets below must be generated in gcp by creating a service account key
+  secrets_json = jsonencode({
+    "private_key_id" = "GCP_PRIVATE_KEY_ID",
+    "private_key"    = "GCP_PRIVATE_KEY"
+  })
+}
+
+# When the workers run in gcp, the catalog can use the identity of the workers
+# instead of a service account key, optionally impersonating another service
+# account.
+resource "boundary_host_catalog_plugin" "gcp_workload_identity_example" {
+  name        = "My gcp workload identity catalog"
+  description = "My fourth host catalog!"
+  scope_id    = boundary_scope.project.id
+  plugin_name = "gcp"
+
+  attributes_json = jsonencode({
+    "disable_credential_rotation" = true,
+    "project_id"                  = "GCP_PROJECT_ID",
+    "zone"                        = "us-central1-a",
+    "target_service_account_id"   = "GCP_TARGET_SERVICE_ACCOUNT_EMAIL"
+  })
+}
@@ -103,4 +103,42 @@ resource "boundary_host_set_plugin" "foodev" {
     "filter" = "tagName eq 'tag-key' and tagValue eq 'foo'",
     "filter" = "tagName eq 'application' and tagValue eq 'dev'",
   })
-}
\ No newline at end of file
+}
+# For more information about the gcp plugin, please visit here:
+# https://github.com/hashicorp/boundary-plugin-gcp
+resource "boundary_host_catalog_plugin" "gcp_example" {
+  name        = "My gcp catalog"
+  description = "My third host catalog!"
+  scope_id    = boundary_scope.project.id
+  plugin_name = "gcp"
+
+  attributes_json = jsonencode({
+    "disable_credential_rotation" = true,
+    "project_id"                  = "GCP_PROJECT_ID",
+    "zone"                        = "us-central1-a",
+    "client_email"                = "GCP_SERVICE_ACCOUNT_EMAIL"
+  })
+
+  # recommended to pass in gcp secrets using a file() or using environment variables
+  secrets_json = jsonencode({
+    "private_key_id" = "GCP_PRIVATE_KEY_ID",
+    "private_key"    = "GCP_PRIVATE_KEY"
+  })
+}
+
+resource "boundary_host_set_plugin" "gcp_web" {
+  name            = "My gcp web host set plugin"
+  host_catalog_id = boundary_host_catalog_plugin.gcp_example.id
+  attributes_json = jsonencode({ "filters" = ["labels.service-type=web"] })
+}
+
+resource "boundary_host_set_plugin" "gcp_group" {
+  name                  = "My gcp instance group host set plugin"
+  host_catalog_id       = boundary_host_catalog_plugin.gcp_example.id
+  preferred_endpoints   = ["cidr:10.0.0.0/8"]
+  sync_interval_seconds = 60
+  attributes_json = jsonencode({
+    "instance_group" = "my-instance-group",
+    "filters"        = ["status=RUNNING"]
+  })
+}
@@ -0,0 +1,37 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_auth_method_ldap" "forumsys_ldap" {
+  name          = "forumsys public LDAP"
+  scope_id      = boundary_scope.org.id
+  urls          = ["ldap://ldap.forumsys.com"]
+  user_dn       = "dc=example,dc=com"
+  user_attr     = "uid"
+  group_dn      = "dc=example,dc=com"
+  bind_dn       = "cn=read-only-admin,dc=example,dc=com"
+  bind_password = "password"
+  enable_groups = true
+  discover_dn   = true
+}
+
+resource "boundary_managed_group_ldap" "scientists" {
+  name           = "scientists"
+  description    = "The scientists of the forumsys LDAP"
+  auth_method_id = boundary_auth_method_ldap.forumsys_ldap.id
+  group_names    = ["Scientists"]
+}
+
+resource "boundary_role" "scientists" {
+  name          = "scientists"
+  scope_id      = boundary_scope.org.id
+  principal_ids = [boundary_managed_group_ldap.scientists.id]
+  grant_strings = ["id=*;type=*;actions=read,list"]
+}
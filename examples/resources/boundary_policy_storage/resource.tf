@@ -0,0 +1,21 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+# Keep session recordings for at least 30 days, and delete them after 90 days
+resource "boundary_policy_storage" "example" {
+  name                     = "30-day retention"
+  description              = "Keep recordings for 30 days"
+  scope_id                 = boundary_scope.org.id
+  retain_for_days          = 30
+  retain_for_overridable   = false
+  delete_after_days        = 90
+  delete_after_overridable = true
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_role_grant.foo "<role_id>:<grant_string>"
\ No newline at end of file
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_role" "shared" {
+  name        = "shared"
+  description = "A role several teams contribute grants to"
+  scope_id    = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [grant_strings]
+  }
+}
+
+resource "boundary_role_grant" "read" {
+  role_id      = boundary_role.shared.id
+  grant_string = "ids=*;type=*;actions=read"
+}
+
+resource "boundary_role_grant" "self" {
+  role_id      = boundary_role.shared.id
+  grant_string = "ids=*;type=account;actions=read:self"
+}
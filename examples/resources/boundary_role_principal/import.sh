@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_role_principal.foo "<role_id>:<principal_id>"
\ No newline at end of file
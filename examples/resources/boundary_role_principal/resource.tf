@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_role" "shared" {
+  name          = "shared"
+  description   = "A role several teams add their users to"
+  grant_strings = ["ids=*;type=*;actions=read"]
+  scope_id      = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [principal_ids]
+  }
+}
+
+resource "boundary_user" "foo" {
+  name     = "foo"
+  scope_id = boundary_scope.org.id
+}
+
+resource "boundary_role_principal" "foo" {
+  role_id      = boundary_role.shared.id
+  principal_id = boundary_user.foo.id
+}
@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_auth_method_password" "global" {
+  name     = "global_password"
+  scope_id = "global"
+}
+
+resource "boundary_scope_global" "global" {
+  name                   = "global"
+  description            = "The global scope of the Boundary cluster"
+  primary_auth_method_id = boundary_auth_method_password.global.id
+}
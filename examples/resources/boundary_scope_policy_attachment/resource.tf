@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_policy_storage" "example" {
+  name              = "30-day retention"
+  scope_id          = boundary_scope.org.id
+  retain_for_days   = 30
+  delete_after_days = 90
+}
+
+resource "boundary_scope_policy_attachment" "example" {
+  scope_id  = boundary_scope.org.id
+  policy_id = boundary_policy_storage.example.id
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_scope_primary_auth_method.foo <my-scope-id>
\ No newline at end of file
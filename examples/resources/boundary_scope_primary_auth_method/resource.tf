@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_auth_method_oidc" "corp" {
+  name               = "corp"
+  scope_id           = boundary_scope.org.id
+  issuer             = "https://sso.example.com"
+  client_id          = "boundary"
+  client_secret      = "secret"
+  signing_algorithms = ["RS256"]
+  api_url_prefix     = "https://boundary.example.com:9200"
+}
+
+# Users logging in with the OIDC auth method for the first time are created
+# automatically
+resource "boundary_scope_primary_auth_method" "org" {
+  scope_id       = boundary_scope.org.id
+  auth_method_id = boundary_auth_method_oidc.corp.id
+}
@@ -0,0 +1,62 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+# Using static credentials
+resource "boundary_storage_bucket" "aws_static_credentials" {
+  name          = "My aws storage bucket with static credentials"
+  description   = "My first storage bucket!"
+  scope_id      = boundary_scope.org.id
+  plugin_name   = "aws"
+  bucket_name   = "mybucket"
+  bucket_prefix = "recordings"
+  worker_filter = "\"s3\" in \"/tags/type\""
+  attributes_json = jsonencode({
+    region                      = "us-east-1"
+    disable_credential_rotation = true
+  })
+  secrets_json = jsonencode({
+    access_key_id     = "aws_access_key_id_value"
+    secret_access_key = "aws_secret_access_key_value"
+  })
+}
+
+# Using a role assumed by the workers
+resource "boundary_storage_bucket" "aws_dynamic_credentials" {
+  name          = "My aws storage bucket with dynamic credentials"
+  description   = "My first storage bucket!"
+  scope_id      = boundary_scope.org.id
+  plugin_name   = "aws"
+  bucket_name   = "mybucket"
+  worker_filter = "\"s3\" in \"/tags/type\""
+  attributes_json = jsonencode({
+    region                      = "us-east-1"
+    role_arn                    = "arn:aws:iam::123456789012:role/boundary-session-recording"
+    disable_credential_rotation = true
+  })
+}
+
+# Using an S3-compatible object store such as MinIO
+resource "boundary_storage_bucket" "minio" {
+  name          = "My minio storage bucket"
+  description   = "My on-prem storage bucket!"
+  scope_id      = boundary_scope.org.id
+  plugin_name   = "minio"
+  bucket_name   = "mybucket"
+  worker_filter = "\"minio\" in \"/tags/type\""
+  attributes_json = jsonencode({
+    endpoint_url = "https://minio.example.com:9000"
+    region       = "us-east-1"
+  })
+  secrets_json = jsonencode({
+    access_key_id     = "minio_access_key_id_value"
+    secret_access_key = "minio_secret_access_key_value"
+  })
+}
@@ -99,3 +99,69 @@ resource "boundary_target" "ssh_foo" {
     boundary_credential_library_vault.foo.id
   ]
 }
+
+resource "boundary_target" "address_foo" {
+  name         = "address_foo"
+  description  = "Foo target with a direct address"
+  type         = "tcp"
+  default_port = "22"
+  scope_id     = boundary_scope.project.id
+  address      = "10.0.0.1"
+}
+
+resource "boundary_target" "aliased_foo" {
+  name         = "aliased_foo"
+  description  = "Foo target reachable through aliases"
+  type         = "tcp"
+  default_port = "22"
+  scope_id     = boundary_scope.project.id
+  address      = "10.0.0.1"
+
+  with_aliases {
+    value = "foo.example.com"
+  }
+}
+
+resource "boundary_storage_bucket" "recordings" {
+  name          = "recordings"
+  description   = "Bucket for the session recordings"
+  scope_id      = boundary_scope.org.id
+  plugin_name   = "aws"
+  bucket_name   = "mybucket"
+  worker_filter = "\"s3\" in \"/tags/type\""
+  attributes_json = jsonencode({
+    region                      = "us-east-1"
+    disable_credential_rotation = true
+  })
+  secrets_json = jsonencode({
+    access_key_id     = "aws_access_key_id_value"
+    secret_access_key = "aws_secret_access_key_value"
+  })
+}
+
+resource "boundary_target" "recorded_ssh_foo" {
+  name                     = "recorded_ssh_foo"
+  description              = "Ssh target with session recording"
+  type                     = "ssh"
+  default_port             = "22"
+  scope_id                 = boundary_scope.project.id
+  enable_session_recording = true
+  storage_bucket_id        = boundary_storage_bucket.recordings.id
+  host_source_ids = [
+    boundary_host_set.foo.id
+  ]
+  injected_application_credential_source_ids = [
+    boundary_credential_library_vault.foo.id
+  ]
+}
+
+resource "boundary_target" "rdp_foo" {
+  name         = "rdp_foo"
+  description  = "Rdp target"
+  type         = "rdp"
+  default_port = "3389"
+  scope_id     = boundary_scope.project.id
+  host_source_ids = [
+    boundary_host_set.foo.id
+  ]
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_target_credential_source.foo "<target_id>:<credential_source_id>"
\ No newline at end of file
@@ -0,0 +1,47 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_target" "ssh" {
+  name         = "ssh"
+  type         = "ssh"
+  scope_id     = boundary_scope.project.id
+  address      = "10.0.0.1"
+  default_port = 22
+
+  lifecycle {
+    ignore_changes = [injected_application_credential_source_ids]
+  }
+}
+
+resource "boundary_credential_store_static" "example" {
+  name     = "example_static_credential_store"
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_credential_ssh_private_key" "example" {
+  name                = "example_ssh_private_key"
+  credential_store_id = boundary_credential_store_static.example.id
+  username            = "ubuntu"
+  private_key         = file("~/.ssh/id_rsa")
+}
+
+resource "boundary_target_credential_source" "example" {
+  target_id            = boundary_target.ssh.id
+  credential_source_id = boundary_credential_ssh_private_key.example.id
+  purpose              = "injected_application"
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_target_host_source.foo "<target_id>:<host_set_id>"
\ No newline at end of file
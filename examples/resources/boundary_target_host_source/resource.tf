@@ -0,0 +1,50 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_target" "shared" {
+  name         = "shared"
+  type         = "tcp"
+  scope_id     = boundary_scope.project.id
+  default_port = 22
+
+  lifecycle {
+    ignore_changes = [host_source_ids]
+  }
+}
+
+resource "boundary_host_catalog_static" "foo" {
+  name     = "foo"
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_host_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+  address         = "10.0.0.1"
+}
+
+resource "boundary_host_set_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+  host_ids        = [boundary_host_static.foo.id]
+}
+
+resource "boundary_target_host_source" "foo" {
+  target_id   = boundary_target.shared.id
+  host_set_id = boundary_host_set_static.foo.id
+}
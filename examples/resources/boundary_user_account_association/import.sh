@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import boundary_user_account_association.foo "<user_id>:<account_id>"
\ No newline at end of file
@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_worker" "controller_led" {
+  scope_id    = "global"
+  name        = "worker 1"
+  description = "self managed worker with controller led auth"
+}
+
+# Pass the activation token to the worker, e.g. through cloud-init
+resource "aws_instance" "worker" {
+  ami           = var.worker_ami
+  instance_type = "t3.small"
+  user_data = templatefile("${path.module}/worker.hcl.tftpl", {
+    activation_token = boundary_worker.controller_led.controller_generated_activation_token
+  })
+}
+
+resource "boundary_worker" "worker_led" {
+  scope_id                    = "global"
+  name                        = "worker 2"
+  description                 = "self managed worker with worker led auth"
+  worker_generated_auth_token = var.worker_generated_auth_token
+}
@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "time_rotating" "worker_ca" {
+  rotation_days = 30
+}
+
+# Rotate the worker CA every 30 days
+resource "boundary_worker_ca_rotation" "global" {
+  rotate_trigger = time_rotating.worker_ca.id
+}
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "boundary_worker_tags" "example" {
+  worker_id = var.worker_id
+
+  tag {
+    key    = "region"
+    values = ["us-east-1"]
+  }
+
+  tag {
+    key    = "type"
+    values = ["prod", "database"]
+  }
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/scopes"
+)
+
+// readCache holds scopes and auth methods read from the controller, keyed by
+// ID. Many resources tend to refer to the same few scopes and auth methods,
+// caching them saves a read per resource when refreshing large states. The
+// cache lives as long as the provider, i.e. a single Terraform command, and
+// an entry is dropped whenever the provider changes the item.
+type readCache struct {
+	mu    sync.Mutex
+	items map[string]map[string]interface{}
+}
+
+func newReadCache() *readCache {
+	return &readCache{items: make(map[string]map[string]interface{})}
+}
+
+// get returns a copy of the cached item, so callers are free to modify it.
+func (c *readCache) get(id string) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+	return copyMap(item), true
+}
+
+func (c *readCache) put(id string, item map[string]interface{}) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[id] = copyMap(item)
+}
+
+func (c *readCache) invalidate(id string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, id)
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// readScope returns the scope with the given ID as a response map, reading it
+// from the controller only if it is not cached yet.
+func (md *metaData) readScope(ctx context.Context, id string) (map[string]interface{}, error) {
+	if item, ok := md.cache.get(id); ok {
+		return item, nil
+	}
+	srr, err := scopes.NewClient(md.client).Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if srr == nil {
+		return nil, nil
+	}
+	item := srr.GetResponse().Map
+	md.cache.put(id, item)
+	return item, nil
+}
+
+// readAuthMethod returns the auth method with the given ID as a response map,
+// reading it from the controller only if it is not cached yet.
+func (md *metaData) readAuthMethod(ctx context.Context, id string) (map[string]interface{}, error) {
+	if item, ok := md.cache.get(id); ok {
+		return item, nil
+	}
+	amrr, err := authmethods.NewClient(md.client).Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if amrr == nil {
+		return nil, nil
+	}
+	item := amrr.GetResponse().Map
+	md.cache.put(id, item)
+	return item, nil
+}
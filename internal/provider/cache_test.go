@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCache(t *testing.T) {
+	const scopeId = "o_1234567890"
+
+	var reads int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/scopes/"+scopeId {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(&reads, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":                     scopeId,
+			"scope_id":               "global",
+			"primary_auth_method_id": "ampw_1234567890",
+		})
+	}))
+	defer srv.Close()
+
+	config, err := api.DefaultConfig()
+	require.NoError(t, err)
+	config.Addr = srv.URL
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+	md := &metaData{client: client, cache: newReadCache()}
+	ctx := context.Background()
+
+	raw, err := md.readScope(ctx, scopeId)
+	require.NoError(t, err)
+	assert.Equal(t, "ampw_1234567890", raw["primary_auth_method_id"])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reads))
+
+	// Changing the returned item must not change the cached one
+	raw["primary_auth_method_id"] = "amoidc_1234567890"
+	raw, err = md.readScope(ctx, scopeId)
+	require.NoError(t, err)
+	assert.Equal(t, "ampw_1234567890", raw["primary_auth_method_id"])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reads))
+
+	// Once invalidated the scope is read again
+	md.cache.invalidate(scopeId)
+	_, err = md.readScope(ctx, scopeId)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reads))
+
+	// Errors are not cached
+	_, err = md.readScope(ctx, "o_unknown")
+	assert.Error(t, err)
+	_, ok := md.cache.get("o_unknown")
+	assert.False(t, ok)
+}
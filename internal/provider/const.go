@@ -35,6 +35,9 @@ const (
 	PreferredEndpointsKey = "preferred_endpoints"
 	// SyncIntervalSecondsKey is used for setting the interval seconds
 	SyncIntervalSecondsKey = "sync_interval_seconds"
+	// DisableCredentialRotationKey is used for the "disable_credential_rotation"
+	// attribute of plugins, which can also be set in the attributes
+	DisableCredentialRotationKey = "disable_credential_rotation"
 	// internalSecretsConfigHmacKey is used for storing an hmac of hmac from server +
 	// config string
 	internalSecretsConfigHmacKey = "internal_secrets_config_hmac"
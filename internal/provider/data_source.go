@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/scopes"
+)
+
+const (
+	// dataSourceFilterKey is used for the Boundary filter expression the
+	// controller applies to the items of list data sources
+	dataSourceFilterKey = "filter"
+	// dataSourceRecursiveKey is used for listing the items of the child scopes
+	// too
+	dataSourceRecursiveKey = "recursive"
+	// dataSourceItemsKey is used for the items returned by list data sources
+	dataSourceItemsKey = "items"
+)
+
+// dataSourceFilterDescription is appended to the description of the filter
+// attribute of every list data source.
+const dataSourceFilterDescription = " The filter is evaluated by the controller against the JSON representation of each item, " +
+	"e.g. `\"/item/name\" matches \"prod-.*\"`, see https://developer.hashicorp.com/boundary/docs/concepts/filtering."
+
+// dataSourceNameFilter returns the filter expression matching the items with
+// exactly the given name.
+func dataSourceNameFilter(name string) string {
+	return fmt.Sprintf(`"/item/name" == %q`, name)
+}
+
+// findScopeIdByName returns the ID of the child scope of parentScopeId with the
+// given name.
+func findScopeIdByName(ctx context.Context, md *metaData, parentScopeId, name string) (string, error) {
+	slr, err := scopes.NewClient(md.client).List(ctx, parentScopeId, scopes.WithFilter(dataSourceNameFilter(name)))
+	if err != nil {
+		return "", fmt.Errorf("error listing scopes: %w", err)
+	}
+	if slr == nil || len(slr.Items) == 0 {
+		return "", fmt.Errorf("no scope named %q found in scope %q", name, parentScopeId)
+	}
+	if len(slr.Items) > 1 {
+		return "", fmt.Errorf("%d scopes named %q found in scope %q", len(slr.Items), name, parentScopeId)
+	}
+	return slr.Items[0].Id, nil
+}
+
+// filterCondition requires the field at the JSON pointer of an item to equal
+// the value.
+type filterCondition struct {
+	pointer, value string
+}
+
+// combineFilter combines the filter of a data source with the conditions whose
+// value is set, so the controller applies all of them.
+func combineFilter(filter string, conditions ...filterCondition) string {
+	var parts []string
+	for _, c := range conditions {
+		if c.value != "" {
+			parts = append(parts, fmt.Sprintf(`%q == %q`, c.pointer, c.value))
+		}
+	}
+	if filter != "" {
+		if len(parts) == 0 {
+			return filter
+		}
+		parts = append(parts, "("+filter+")")
+	}
+	return strings.Join(parts, " and ")
+}
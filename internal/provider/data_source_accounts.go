@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAccounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "The accounts data source lists the accounts of an auth method, optionally filtered by the " +
+			"controller, so accounts created outside of Terraform, e.g. when users first log in with an OIDC or " +
+			"LDAP auth method, can be associated with users.",
+
+		ReadContext: dataSourceAccountsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the auth method the accounts were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			AuthMethodIdKey: {
+				Description: "The ID of the auth method to list the accounts of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the accounts must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The accounts found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the account, e.g. `password`, `oidc` or `ldap`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						accountLoginNameKey: {
+							Description: "The login name of the account, for `password` and `ldap` accounts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						accountOidcIssuerKey: {
+							Description: "The issuer of the account, for `oidc` accounts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						accountOidcSubjectKey: {
+							Description: "The subject of the account, for `oidc` accounts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						accountLdapFullNameKey: {
+							Description: "The full name of the account, for `oidc` and `ldap` accounts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						accountLdapEmailKey: {
+							Description: "The email of the account, for `oidc` and `ldap` accounts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := accounts.NewClient(md.client)
+
+	authMethodId := d.Get(AuthMethodIdKey).(string)
+
+	var opts []accounts.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, accounts.WithFilter(filter))
+	}
+
+	alr, err := aClient.List(ctx, authMethodId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing accounts: %v", err)
+	}
+	if alr == nil {
+		return diag.Errorf("account list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(alr.Items))
+	for _, a := range alr.Items {
+		item := map[string]interface{}{
+			IDKey:          a.Id,
+			NameKey:        a.Name,
+			DescriptionKey: a.Description,
+			TypeKey:        a.Type,
+		}
+		// The attributes differ per type of account, the controller leaves
+		// out the ones that don't apply
+		for _, k := range []string{
+			accountLoginNameKey,
+			accountOidcIssuerKey,
+			accountOidcSubjectKey,
+			accountLdapFullNameKey,
+			accountLdapEmailKey,
+		} {
+			item[k], _ = a.Attributes[k].(string)
+		}
+		items = append(items, item)
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(authMethodId)
+
+	return nil
+}
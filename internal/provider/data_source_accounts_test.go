@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooAccountsDataSource = `
+resource "boundary_auth_method_password" "foo" {
+	name       = "foo"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+resource "boundary_account_password" "alice" {
+	name           = "alice"
+	description    = "foo"
+	type           = "password"
+	login_name     = "alice"
+	password       = "foofoofoo"
+	auth_method_id = boundary_auth_method_password.foo.id
+}
+
+resource "boundary_account_password" "bob" {
+	type           = "password"
+	login_name     = "bob"
+	password       = "foofoofoo"
+	auth_method_id = boundary_auth_method_password.foo.id
+}
+
+data "boundary_accounts" "all" {
+	auth_method_id = boundary_auth_method_password.foo.id
+	depends_on     = [boundary_account_password.alice, boundary_account_password.bob]
+}
+
+data "boundary_accounts" "alice" {
+	auth_method_id = boundary_auth_method_password.foo.id
+	filter         = "\"/item/attributes/login_name\" == \"alice\""
+	depends_on     = [boundary_account_password.alice, boundary_account_password.bob]
+}`
+
+func TestAccDataSourceAccounts(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, fooAccountsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_accounts.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_accounts.all", IDKey, "boundary_auth_method_password.foo", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_accounts.alice", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_accounts.alice", "items.0.id", "boundary_account_password.alice", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_accounts.alice", "items.0.name", "alice"),
+					resource.TestCheckResourceAttr("data.boundary_accounts.alice", "items.0.description", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_accounts.alice", "items.0.type", "password"),
+					resource.TestCheckResourceAttr("data.boundary_accounts.alice", "items.0.login_name", "alice"),
+					resource.TestCheckResourceAttr("data.boundary_accounts.alice", "items.0.subject", ""),
+				),
+			},
+		},
+	})
+}
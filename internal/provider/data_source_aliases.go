@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAliases() *schema.Resource {
+	return &schema.Resource{
+		Description: "The aliases data source lists the aliases, optionally only the ones matching a filter " +
+			"evaluated by the controller, e.g. to check whether an alias value is taken or to reference an " +
+			"alias managed elsewhere.",
+
+		ReadContext: dataSourceAliasesRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the aliases were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope to list the aliases of. Defaults to `global`, the only scope aliases can be created in.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the aliases must match, which can check the alias value with `/item/value`." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The aliases found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope the alias is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						aliasValueKey: {
+							Description: "The value of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						aliasDestinationIdKey: {
+							Description: "The ID of the resource the alias points to, empty if it has no destination.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						aliasAuthorizeSessionHostIdKey: {
+							Description: "The ID of the host sessions connecting through the alias use.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	alc := aliases.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+
+	var opts []aliases.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, aliases.WithFilter(filter))
+	}
+
+	alr, err := alc.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing aliases: %v", err)
+	}
+	if alr == nil {
+		return diag.Errorf("alias list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(alr.Items))
+	for _, a := range alr.Items {
+		var hostId string
+		if args, ok := a.Attributes["authorize_session_arguments"].(map[string]interface{}); ok {
+			hostId, _ = args["host_id"].(string)
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:                          a.Id,
+			ScopeIdKey:                     a.ScopeId,
+			NameKey:                        a.Name,
+			DescriptionKey:                 a.Description,
+			TypeKey:                        a.Type,
+			aliasValueKey:                  a.Value,
+			aliasDestinationIdKey:          a.DestinationId,
+			aliasAuthorizeSessionHostIdKey: hostId,
+		})
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fooAliasesDataSource = `
+data "boundary_aliases" "foo" {
+	filter     = "\"/item/name\" == \"test\""
+	depends_on = [boundary_alias_target.foo]
+}`
+
+func TestAccDataSourceAliases(t *testing.T) {
+	skipForTestControllerVersion(t, "0.16.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, aliasTargetTarget, fooAliasTarget, fooAliasesDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_aliases.foo", IDKey, "global"),
+					resource.TestCheckResourceAttr("data.boundary_aliases.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_aliases.foo", "items.0.id", "boundary_alias_target.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_aliases.foo", "items.0.scope_id", "global"),
+					resource.TestCheckResourceAttr("data.boundary_aliases.foo", "items.0.description", "test alias"),
+					resource.TestCheckResourceAttr("data.boundary_aliases.foo", "items.0.type", "target"),
+					resource.TestCheckResourceAttr("data.boundary_aliases.foo", "items.0.value", fooAliasValue),
+					resource.TestCheckResourceAttrPair("data.boundary_aliases.foo", "items.0.destination_id", "boundary_target.foo", IDKey),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceAliasesRead(t *testing.T) {
+	const filter = `"/item/value" == "foo.example.com"`
+	md := testApiMetaData(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/aliases", r.URL.Path)
+		assert.Equal(t, "global", r.URL.Query().Get("scope_id"))
+		assert.Equal(t, filter, r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+	"response_type": "complete",
+	"items": [
+		{
+			"id": "alt_1234567890",
+			"scope_id": "global",
+			"type": "target",
+			"value": "foo.example.com",
+			"destination_id": "ttcp_1234567890",
+			"attributes": {"authorize_session_arguments": {"host_id": "hst_1234567890"}}
+		},
+		{
+			"id": "alt_0987654321",
+			"scope_id": "global",
+			"type": "target",
+			"value": "bar.example.com"
+		}
+	]
+}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceAliases().Schema, map[string]interface{}{
+		dataSourceFilterKey: filter,
+	})
+	require.False(t, dataSourceAliasesRead(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "global", d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			IDKey:                          "alt_1234567890",
+			ScopeIdKey:                     "global",
+			NameKey:                        "",
+			DescriptionKey:                 "",
+			TypeKey:                        "target",
+			aliasValueKey:                  "foo.example.com",
+			aliasDestinationIdKey:          "ttcp_1234567890",
+			aliasAuthorizeSessionHostIdKey: "hst_1234567890",
+		},
+		map[string]interface{}{
+			IDKey:                          "alt_0987654321",
+			ScopeIdKey:                     "global",
+			NameKey:                        "",
+			DescriptionKey:                 "",
+			TypeKey:                        "target",
+			aliasValueKey:                  "bar.example.com",
+			aliasDestinationIdKey:          "",
+			aliasAuthorizeSessionHostIdKey: "",
+		},
+	}, d.Get(dataSourceItemsKey))
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const authMethodIsPrimaryKey = "is_primary"
+
+func dataSourceAuthMethods() *schema.Resource {
+	return &schema.Resource{
+		Description: "The auth methods data source lists the auth methods of a scope, optionally including the ones " +
+			"of its child scopes and filtered by the controller, so the ID of an existing auth method doesn't need " +
+			"to be hardcoded, e.g. in accounts.",
+
+		ReadContext: dataSourceAuthMethodsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the auth methods were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the auth methods of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the auth methods of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the auth methods must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The auth methods found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the auth method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the auth method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the auth method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the auth method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the auth method, e.g. `password`, `oidc` or `ldap`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						authMethodIsPrimaryKey: {
+							Description: "Whether the auth method is the primary auth method of its scope.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthMethodsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	amClient := authmethods.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []authmethods.Option{authmethods.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, authmethods.WithFilter(filter))
+	}
+
+	amlr, err := amClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing auth methods: %v", err)
+	}
+	if amlr == nil {
+		return diag.Errorf("auth method list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(amlr.Items))
+	for _, am := range amlr.Items {
+		items = append(items, map[string]interface{}{
+			IDKey:                  am.Id,
+			ScopeIdKey:             am.ScopeId,
+			NameKey:                am.Name,
+			DescriptionKey:         am.Description,
+			TypeKey:                am.Type,
+			authMethodIsPrimaryKey: am.IsPrimary,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
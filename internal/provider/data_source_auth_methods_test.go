@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooAuthMethodsDataSource = `
+resource "boundary_auth_method_password" "foo" {
+	name        = "foo"
+	description = "bar"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+}
+
+resource "boundary_auth_method_password" "bar" {
+	name       = "bar"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+data "boundary_auth_methods" "all" {
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_auth_method_password.foo, boundary_auth_method_password.bar]
+}
+
+data "boundary_auth_methods" "foo" {
+	scope_id   = boundary_scope.org1.id
+	filter     = "\"/item/name\" == \"foo\""
+	depends_on = [boundary_auth_method_password.foo, boundary_auth_method_password.bar]
+}`
+
+func TestAccDataSourceAuthMethods(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, fooAuthMethodsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_auth_methods.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_auth_methods.all", ScopeIdKey, "boundary_scope.org1", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_auth_methods.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_auth_methods.foo", "items.0.id", "boundary_auth_method_password.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_auth_methods.foo", "items.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_auth_methods.foo", "items.0.description", "bar"),
+					resource.TestCheckResourceAttr("data.boundary_auth_methods.foo", "items.0.type", "password"),
+					resource.TestCheckResourceAttr("data.boundary_auth_methods.foo", "items.0.is_primary", "false"),
+				),
+			},
+		},
+	})
+}
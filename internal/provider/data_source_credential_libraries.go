@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCredentialLibraries() *schema.Resource {
+	return &schema.Resource{
+		Description: "The credential libraries data source lists the credential libraries of a credential store, " +
+			"optionally filtered by the controller, so targets can use existing credential libraries by name " +
+			"instead of by ID.",
+
+		ReadContext: dataSourceCredentialLibrariesRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the credential store the credential libraries were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreIdKey: {
+				Description: "The ID of the credential store to list the credential libraries of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the credential libraries must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The credential libraries found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the credential library.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the credential library.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the credential library.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the credential library, e.g. `vault-generic` or `vault-ssh-certificate`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialLibraryCredentialTypeKey: {
+							Description: "The type of the credentials the library issues, if set.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialLibraryVaultPathKey: {
+							Description: "The Vault path the library reads the credentials from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialLibraryVaultHttpMethodKey: {
+							Description: "The HTTP method the library uses to read the credentials, for `vault-generic` credential libraries.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialLibrariesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	client := credentiallibraries.NewClient(md.client)
+
+	credentialStoreId := d.Get(credentialStoreIdKey).(string)
+
+	var opts []credentiallibraries.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, credentiallibraries.WithFilter(filter))
+	}
+
+	cllr, err := client.List(ctx, credentialStoreId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing credential libraries: %v", err)
+	}
+	if cllr == nil {
+		return diag.Errorf("credential library list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(cllr.Items))
+	for _, cl := range cllr.Items {
+		path, _ := cl.Attributes[credentialLibraryVaultPathKey].(string)
+		httpMethod, _ := cl.Attributes[credentialLibraryVaultHttpMethodKey].(string)
+		items = append(items, map[string]interface{}{
+			IDKey:                               cl.Id,
+			NameKey:                             cl.Name,
+			DescriptionKey:                      cl.Description,
+			TypeKey:                             cl.Type,
+			credentialLibraryCredentialTypeKey:  cl.CredentialType,
+			credentialLibraryVaultPathKey:       path,
+			credentialLibraryVaultHttpMethodKey: httpMethod,
+		})
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(credentialStoreId)
+
+	return nil
+}
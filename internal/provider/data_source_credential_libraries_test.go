@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooCredentialLibrariesDataSource = `
+resource "boundary_credential_library_vault" "typed" {
+	name                = "typed"
+	credential_store_id = boundary_credential_store_vault.example.id
+	path                = "/foo/typed"
+	http_method         = "GET"
+	credential_type     = "username_password"
+}
+
+data "boundary_credential_libraries" "all" {
+	credential_store_id = boundary_credential_store_vault.example.id
+	depends_on          = [boundary_credential_library_vault.example, boundary_credential_library_vault.typed]
+}
+
+data "boundary_credential_libraries" "typed" {
+	credential_store_id = boundary_credential_store_vault.example.id
+	filter              = "\"/item/credential_type\" == \"username_password\""
+	depends_on          = [boundary_credential_library_vault.example, boundary_credential_library_vault.typed]
+}`
+
+func TestAccDataSourceCredentialLibraries(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultCredLibResource, fooCredentialLibrariesDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_credential_libraries.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_credential_libraries.all", IDKey, "boundary_credential_store_vault.example", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_credential_libraries.typed", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_credential_libraries.typed", "items.0.id", "boundary_credential_library_vault.typed", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_credential_libraries.typed", "items.0.name", "typed"),
+					resource.TestCheckResourceAttr("data.boundary_credential_libraries.typed", "items.0.credential_type", "username_password"),
+					resource.TestCheckResourceAttr("data.boundary_credential_libraries.typed", "items.0.path", "/foo/typed"),
+					resource.TestCheckResourceAttr("data.boundary_credential_libraries.typed", "items.0.http_method", vaultCredLibMethodGet),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCredentialStores() *schema.Resource {
+	return &schema.Resource{
+		Description: "The credential stores data source lists the static and Vault credential stores of a scope, " +
+			"optionally including the ones of its child scopes and filtered by the controller, so credential " +
+			"libraries and credentials can be added to stores managed elsewhere.",
+
+		ReadContext: dataSourceCredentialStoresRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the credential stores were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the credential stores of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the credential stores of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the credential stores must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The credential stores found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the credential store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the credential store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the credential store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the credential store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the credential store, either `static` or `vault`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialStoreVaultAddressKey: {
+							Description: "The address of the Vault server, for `vault` credential stores.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialStoreVaultNamespaceKey: {
+							Description: "The Vault namespace, for `vault` credential stores.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialStoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	csClient := credentialstores.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []credentialstores.Option{credentialstores.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, credentialstores.WithFilter(filter))
+	}
+
+	cslr, err := csClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing credential stores: %v", err)
+	}
+	if cslr == nil {
+		return diag.Errorf("credential store list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(cslr.Items))
+	for _, cs := range cslr.Items {
+		address, _ := cs.Attributes[credentialStoreVaultAddressKey].(string)
+		namespace, _ := cs.Attributes[credentialStoreVaultNamespaceKey].(string)
+		items = append(items, map[string]interface{}{
+			IDKey:                            cs.Id,
+			ScopeIdKey:                       cs.ScopeId,
+			NameKey:                          cs.Name,
+			DescriptionKey:                   cs.Description,
+			TypeKey:                          cs.Type,
+			credentialStoreVaultAddressKey:   address,
+			credentialStoreVaultNamespaceKey: namespace,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
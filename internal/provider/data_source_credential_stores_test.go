@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooCredentialStoresDataSource = `
+resource "boundary_credential_store_static" "foo" {
+	name        = "foo"
+	description = "bar"
+	scope_id    = boundary_scope.proj1.id
+	depends_on  = [boundary_role.proj1_admin]
+}
+
+resource "boundary_credential_store_static" "bar" {
+	name       = "bar"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}
+
+data "boundary_credential_stores" "all" {
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_credential_store_static.foo, boundary_credential_store_static.bar]
+}
+
+data "boundary_credential_stores" "foo" {
+	scope_id   = boundary_scope.org1.id
+	recursive  = true
+	filter     = "\"/item/name\" == \"foo\""
+	depends_on = [boundary_credential_store_static.foo, boundary_credential_store_static.bar]
+}`
+
+func TestAccDataSourceCredentialStores(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooCredentialStoresDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_credential_stores.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_credential_stores.all", ScopeIdKey, "boundary_scope.proj1", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_credential_stores.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_credential_stores.foo", "items.0.id", "boundary_credential_store_static.foo", IDKey),
+					resource.TestCheckResourceAttrPair("data.boundary_credential_stores.foo", "items.0.scope_id", "boundary_scope.proj1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_credential_stores.foo", "items.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_credential_stores.foo", "items.0.description", "bar"),
+					resource.TestCheckResourceAttr("data.boundary_credential_stores.foo", "items.0.type", "static"),
+					resource.TestCheckResourceAttr("data.boundary_credential_stores.foo", "items.0.address", ""),
+				),
+			},
+		},
+	})
+}
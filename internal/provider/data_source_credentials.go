@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "The credentials data source lists the credentials of a static credential store, optionally " +
+			"filtered by the controller, so existing credentials can be used by targets. The secrets of the " +
+			"credentials are never returned, only their HMACs.",
+
+		ReadContext: dataSourceCredentialsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the credential store the credentials were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			credentialStoreIdKey: {
+				Description: "The ID of the credential store to list the credentials of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the credentials must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The credentials found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the credential.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the credential.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the credential.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the credential, e.g. `username_password`, `ssh_private_key` or `json`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialUsernamePasswordUsernameKey: {
+							Description: "The username of the credential, for `username_password` and `ssh_private_key` credentials.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialUsernamePasswordPasswordHmacKey: {
+							Description: "The HMAC of the password, for `username_password` credentials.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialSshPrivateKeyPrivateKeyHmacKey: {
+							Description: "The HMAC of the private key, for `ssh_private_key` credentials.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialSshPrivateKeyPassphraseHmacKey: {
+							Description: "The HMAC of the private key passphrase, for `ssh_private_key` credentials.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialJsonObjectHmacKey: {
+							Description: "The HMAC of the object, for `json` credentials.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	client := credentials.NewClient(md.client)
+
+	credentialStoreId := d.Get(credentialStoreIdKey).(string)
+
+	var opts []credentials.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, credentials.WithFilter(filter))
+	}
+
+	clr, err := client.List(ctx, credentialStoreId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing credentials: %v", err)
+	}
+	if clr == nil {
+		return diag.Errorf("credential list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(clr.Items))
+	for _, c := range clr.Items {
+		item := map[string]interface{}{
+			IDKey:          c.Id,
+			NameKey:        c.Name,
+			DescriptionKey: c.Description,
+			TypeKey:        c.Type,
+		}
+		// The attributes differ per type of credential, the controller leaves
+		// out the ones that don't apply
+		for _, k := range []string{
+			credentialUsernamePasswordUsernameKey,
+			credentialUsernamePasswordPasswordHmacKey,
+			credentialSshPrivateKeyPrivateKeyHmacKey,
+			credentialSshPrivateKeyPassphraseHmacKey,
+			credentialJsonObjectHmacKey,
+		} {
+			item[k], _ = c.Attributes[k].(string)
+		}
+		items = append(items, item)
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(credentialStoreId)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooCredentialsDataSource = `
+resource "boundary_credential_username_password" "foo" {
+	name                = "foo"
+	description         = "bar"
+	credential_store_id = boundary_credential_store_static.ssh_store.id
+	username            = "user"
+	password            = "secret"
+}
+
+resource "boundary_credential_json" "bar" {
+	name                = "bar"
+	credential_store_id = boundary_credential_store_static.ssh_store.id
+	object              = jsonencode({ "key" = "value" })
+}
+
+data "boundary_credentials" "all" {
+	credential_store_id = boundary_credential_store_static.ssh_store.id
+	depends_on          = [boundary_credential_username_password.foo, boundary_credential_json.bar]
+}
+
+data "boundary_credentials" "foo" {
+	credential_store_id = boundary_credential_store_static.ssh_store.id
+	filter              = "\"/item/type\" == \"username_password\""
+	depends_on          = [boundary_credential_username_password.foo, boundary_credential_json.bar]
+}`
+
+func TestAccDataSourceCredentials(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, staticStore, fooCredentialsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_credentials.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_credentials.all", IDKey, "boundary_credential_store_static.ssh_store", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_credentials.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_credentials.foo", "items.0.id", "boundary_credential_username_password.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_credentials.foo", "items.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_credentials.foo", "items.0.description", "bar"),
+					resource.TestCheckResourceAttr("data.boundary_credentials.foo", "items.0.type", "username_password"),
+					resource.TestCheckResourceAttr("data.boundary_credentials.foo", "items.0.username", "user"),
+					resource.TestCheckResourceAttrPair("data.boundary_credentials.foo", "items.0.password_hmac", "boundary_credential_username_password.foo", credentialUsernamePasswordPasswordHmacKey),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/groups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "The groups data source lists the groups of a scope with their members, optionally including " +
+			"the ones of its child scopes and filtered by the controller, so existing groups can be used as the " +
+			"principals of roles.",
+
+		ReadContext: dataSourceGroupsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the groups were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the groups of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the groups of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the groups must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The groups found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						groupMemberIdsKey: {
+							Description: "The IDs of the members of the group.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []groups.Option{groups.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, groups.WithFilter(filter))
+	}
+
+	glr, err := grps.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing groups: %v", err)
+	}
+	if glr == nil {
+		return diag.Errorf("group list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(glr.Items))
+	for _, g := range glr.Items {
+		// The controller leaves the members out of the listed groups
+		grr, err := grps.Read(ctx, g.Id)
+		if err != nil {
+			return diag.Errorf("error reading group %q: %v", g.Id, err)
+		}
+		if grr == nil {
+			return diag.Errorf("group nil after read")
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:             g.Id,
+			ScopeIdKey:        g.ScopeId,
+			NameKey:           g.Name,
+			DescriptionKey:    g.Description,
+			groupMemberIdsKey: grr.Item.MemberIds,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooGroupsDataSource = `
+resource "boundary_user" "foo" {
+	name       = "foo"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+resource "boundary_group" "ops" {
+	name        = "ops"
+	description = "foo"
+	scope_id    = boundary_scope.org1.id
+	member_ids  = [boundary_user.foo.id]
+	depends_on  = [boundary_role.org1_admin]
+}
+
+resource "boundary_group" "dev" {
+	name       = "dev"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+data "boundary_groups" "all" {
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_group.ops, boundary_group.dev]
+}
+
+data "boundary_groups" "ops" {
+	scope_id   = boundary_scope.org1.id
+	filter     = "\"/item/name\" == \"ops\""
+	depends_on = [boundary_group.ops, boundary_group.dev]
+}`
+
+func TestAccDataSourceGroups(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, fooGroupsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_groups.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_groups.all", ScopeIdKey, "boundary_scope.org1", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_groups.ops", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_groups.ops", "items.0.id", "boundary_group.ops", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_groups.ops", "items.0.name", "ops"),
+					resource.TestCheckResourceAttr("data.boundary_groups.ops", "items.0.description", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_groups.ops", "items.0.member_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_groups.ops", "items.0.member_ids.0", "boundary_user.foo", IDKey),
+				),
+			},
+		},
+	})
+}
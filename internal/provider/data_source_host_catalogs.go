@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceHostCatalogs() *schema.Resource {
+	return &schema.Resource{
+		Description: "The host catalogs data source lists the static and plugin host catalogs of a scope, optionally " +
+			"including the ones of its child scopes and filtered by the controller, so host catalogs managed " +
+			"elsewhere can be referenced.",
+
+		ReadContext: dataSourceHostCatalogsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the host catalogs were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the host catalogs of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the host catalogs of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the host catalogs must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The host catalogs found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the host catalog.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the host catalog.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the host catalog.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the host catalog.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the host catalog, either `static` or `plugin`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						PluginIdKey: {
+							Description: "The ID of the plugin of the host catalog, for `plugin` host catalogs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						PluginNameKey: {
+							Description: "The name of the plugin of the host catalog, e.g. `aws` or `azure`, for `plugin` host catalogs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostCatalogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	hcClient := hostcatalogs.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []hostcatalogs.Option{hostcatalogs.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, hostcatalogs.WithFilter(filter))
+	}
+
+	hclr, err := hcClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing host catalogs: %v", err)
+	}
+	if hclr == nil {
+		return diag.Errorf("host catalog list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(hclr.Items))
+	for _, hc := range hclr.Items {
+		var pluginName string
+		if hc.Plugin != nil {
+			pluginName = hc.Plugin.Name
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:          hc.Id,
+			ScopeIdKey:     hc.ScopeId,
+			NameKey:        hc.Name,
+			DescriptionKey: hc.Description,
+			TypeKey:        hc.Type,
+			PluginIdKey:    hc.PluginId,
+			PluginNameKey:  pluginName,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceHostSets() *schema.Resource {
+	return &schema.Resource{
+		Description: "The host sets data source lists the host sets of a host catalog with their hosts, optionally " +
+			"filtered by the controller, so targets can use host sets managed elsewhere or populated by dynamic " +
+			"host discovery.",
+
+		ReadContext: dataSourceHostSetsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the host catalog the host sets were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			HostCatalogIdKey: {
+				Description: "The ID of the host catalog to list the host sets of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the host sets must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The host sets found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the host set.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the host set.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the host set.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the host set, either `static` or `plugin`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostSetHostIdsKey: {
+							Description: "The IDs of the hosts in the host set.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	hsClient := hostsets.NewClient(md.client)
+
+	hostCatalogId := d.Get(HostCatalogIdKey).(string)
+
+	var opts []hostsets.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, hostsets.WithFilter(filter))
+	}
+
+	hslr, err := hsClient.List(ctx, hostCatalogId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing host sets: %v", err)
+	}
+	if hslr == nil {
+		return diag.Errorf("host set list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(hslr.Items))
+	for _, hs := range hslr.Items {
+		// The controller leaves the hosts out of the listed host sets
+		hsrr, err := hsClient.Read(ctx, hs.Id)
+		if err != nil {
+			return diag.Errorf("error reading host set %q: %v", hs.Id, err)
+		}
+		if hsrr == nil {
+			return diag.Errorf("host set nil after read")
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:             hs.Id,
+			NameKey:           hs.Name,
+			DescriptionKey:    hs.Description,
+			TypeKey:           hs.Type,
+			hostSetHostIdsKey: hsrr.Item.HostIds,
+		})
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(hostCatalogId)
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	hostExternalIdKey   = "external_id"
+	hostExternalNameKey = "external_name"
+	hostIpAddressesKey  = "ip_addresses"
+	hostDnsNamesKey     = "dns_names"
+	hostHostSetIdsKey   = "host_set_ids"
+)
+
+func dataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		Description: "The hosts data source lists the hosts of a host catalog, optionally filtered by the controller. " +
+			"For plugin host catalogs these are the hosts discovered by the plugin, with their IDs and addresses in " +
+			"the external provider.",
+
+		ReadContext: dataSourceHostsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the host catalog the hosts were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			HostCatalogIdKey: {
+				Description: "The ID of the host catalog to list the hosts of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the hosts must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The hosts found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the host.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the host.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the host.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the host, either `static` or `plugin`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostAddressKey: {
+							Description: "The address of the host, for `static` hosts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostExternalIdKey: {
+							Description: "The ID of the host in the external provider, for `plugin` hosts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostExternalNameKey: {
+							Description: "The name of the host in the external provider, for `plugin` hosts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostIpAddressesKey: {
+							Description: "The IP addresses of the host, for `plugin` hosts.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						hostDnsNamesKey: {
+							Description: "The DNS names of the host, for `plugin` hosts.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						hostHostSetIdsKey: {
+							Description: "The IDs of the host sets the host is in.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	hClient := hosts.NewClient(md.client)
+
+	hostCatalogId := d.Get(HostCatalogIdKey).(string)
+
+	var opts []hosts.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, hosts.WithFilter(filter))
+	}
+
+	hlr, err := hClient.List(ctx, hostCatalogId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing hosts: %v", err)
+	}
+	if hlr == nil {
+		return diag.Errorf("host list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(hlr.Items))
+	for _, h := range hlr.Items {
+		address, _ := h.Attributes[hostAddressKey].(string)
+		items = append(items, map[string]interface{}{
+			IDKey:               h.Id,
+			NameKey:             h.Name,
+			DescriptionKey:      h.Description,
+			TypeKey:             h.Type,
+			hostAddressKey:      address,
+			hostExternalIdKey:   h.ExternalId,
+			hostExternalNameKey: h.ExternalName,
+			hostIpAddressesKey:  h.IpAddresses,
+			hostDnsNamesKey:     h.DnsNames,
+			hostHostSetIdsKey:   h.HostSetIds,
+		})
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(hostCatalogId)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooHostsDataSource = `
+resource "boundary_host_catalog_static" "foo" {
+	name       = "foo"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}
+
+resource "boundary_host_static" "web" {
+	name            = "web"
+	description     = "foo"
+	address         = "10.0.0.1"
+	host_catalog_id = boundary_host_catalog_static.foo.id
+}
+
+resource "boundary_host_static" "db" {
+	name            = "db"
+	address         = "10.0.0.2"
+	host_catalog_id = boundary_host_catalog_static.foo.id
+}
+
+data "boundary_hosts" "all" {
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	depends_on      = [boundary_host_static.web, boundary_host_static.db]
+}
+
+data "boundary_hosts" "web" {
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	filter          = "\"/item/name\" == \"web\""
+	depends_on      = [boundary_host_static.web, boundary_host_static.db]
+}`
+
+func TestAccDataSourceHosts(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooHostsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_hosts.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_hosts.all", IDKey, "boundary_host_catalog_static.foo", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_hosts.web", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_hosts.web", "items.0.id", "boundary_host_static.web", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_hosts.web", "items.0.name", "web"),
+					resource.TestCheckResourceAttr("data.boundary_hosts.web", "items.0.description", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_hosts.web", "items.0.type", "static"),
+					resource.TestCheckResourceAttr("data.boundary_hosts.web", "items.0.address", "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.boundary_hosts.web", "items.0.external_id", ""),
+				),
+			},
+		},
+	})
+}
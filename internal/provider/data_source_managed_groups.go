@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceManagedGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "The managed groups data source lists the managed groups of an auth method with their current " +
+			"members, optionally filtered by the controller, e.g. to audit them or to use them as the principals " +
+			"of roles.",
+
+		ReadContext: dataSourceManagedGroupsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the auth method the managed groups were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			AuthMethodIdKey: {
+				Description: "The ID of the auth method to list the managed groups of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the managed groups must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The managed groups found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the managed group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the managed group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the managed group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the managed group, either `oidc` or `ldap`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						managedGroupFilterKey: {
+							Description: "The Boolean expression accounts must match to be members, for `oidc` managed groups.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						managedGroupLdapGroupNamesKey: {
+							Description: "The LDAP groups accounts must be in to be members, for `ldap` managed groups.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						managedGroupMemberIdsKey: {
+							Description: "The IDs of the accounts currently in the managed group.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagedGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grpClient := managedgroups.NewClient(md.client)
+
+	authMethodId := d.Get(AuthMethodIdKey).(string)
+
+	var opts []managedgroups.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, managedgroups.WithFilter(filter))
+	}
+
+	mglr, err := grpClient.List(ctx, authMethodId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing managed groups: %v", err)
+	}
+	if mglr == nil {
+		return diag.Errorf("managed group list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(mglr.Items))
+	for _, g := range mglr.Items {
+		// The controller leaves the members out of the listed managed groups
+		mgrr, err := grpClient.Read(ctx, g.Id)
+		if err != nil {
+			return diag.Errorf("error reading managed group %q: %v", g.Id, err)
+		}
+		if mgrr == nil {
+			return diag.Errorf("managed group nil after read")
+		}
+		filter, _ := g.Attributes[managedGroupFilterKey].(string)
+		items = append(items, map[string]interface{}{
+			IDKey:                         g.Id,
+			NameKey:                       g.Name,
+			DescriptionKey:                g.Description,
+			TypeKey:                       g.Type,
+			managedGroupFilterKey:         filter,
+			managedGroupLdapGroupNamesKey: g.Attributes[managedGroupLdapGroupNamesKey],
+			managedGroupMemberIdsKey:      mgrr.Item.MemberIds,
+		})
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(authMethodId)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooManagedGroupsDataSource = `
+data "boundary_managed_groups" "foo" {
+	auth_method_id = boundary_auth_method_oidc.foo.id
+	filter         = "\"/item/name\" == \"test\""
+	depends_on     = [boundary_managed_group.foo]
+}`
+
+func TestAccDataSourceManagedGroups(t *testing.T) {
+	wrapper := testWrapper(context.Background(), t, tcRecoveryKey)
+	tp := oidc.StartTestProvider(t)
+	tc := controller.NewTestController(t, append(tcConfig, controller.WithRecoveryKms(wrapper))...)
+
+	tpCert := strings.TrimSpace(tp.CACert())
+	createConfig := fmt.Sprintf(fooAuthMethodOidc, fooAuthMethodOidcDesc, tp.Addr(), tpCert)
+
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, createConfig, fooManagedGroup, fooManagedGroupsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.boundary_managed_groups.foo", IDKey, "boundary_auth_method_oidc.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_managed_groups.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_managed_groups.foo", "items.0.id", "boundary_managed_group.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_managed_groups.foo", "items.0.name", managedGroupName),
+					resource.TestCheckResourceAttr("data.boundary_managed_groups.foo", "items.0.description", managedGroupDescription),
+					resource.TestCheckResourceAttr("data.boundary_managed_groups.foo", "items.0.type", "oidc"),
+					resource.TestCheckResourceAttr("data.boundary_managed_groups.foo", "items.0.filter", `name == "foo"`),
+					resource.TestCheckResourceAttr("data.boundary_managed_groups.foo", "items.0.member_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
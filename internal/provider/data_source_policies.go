@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "The policies data source lists the storage policies of a scope, optionally including the " +
+			"ones of its child scopes and filtered by the controller, so policies managed elsewhere can be " +
+			"attached with `boundary_scope_policy_attachment`.",
+
+		ReadContext: dataSourcePoliciesRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the policies were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the policies of, either global or an org." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the policies of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the policies must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The policies found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the policy, e.g. `storage`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						policyStorageRetainForDaysKey: {
+							Description: "The number of days session recordings must be kept for, -1 to keep them forever.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						policyStorageRetainForOverridableKey: {
+							Description: "Whether the retention period can be overridden by the policy of a child scope.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						policyStorageDeleteAfterDaysKey: {
+							Description: "The number of days after which session recordings are deleted, 0 if they aren't deleted automatically.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						policyStorageDeleteAfterOverridableKey: {
+							Description: "Whether the deletion period can be overridden by the policy of a child scope.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	pClient := policies.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []policies.Option{policies.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, policies.WithFilter(filter))
+	}
+
+	plr, err := pClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing policies: %v", err)
+	}
+	if plr == nil {
+		return diag.Errorf("policy list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(plr.Items))
+	for _, p := range plr.Items {
+		retainForDays, retainForOverridable := policyStoragePeriod(p.Attributes, "retain_for")
+		deleteAfterDays, deleteAfterOverridable := policyStoragePeriod(p.Attributes, "delete_after")
+		items = append(items, map[string]interface{}{
+			IDKey:                                  p.Id,
+			ScopeIdKey:                             p.ScopeId,
+			NameKey:                                p.Name,
+			DescriptionKey:                         p.Description,
+			TypeKey:                                p.Type,
+			policyStorageRetainForDaysKey:          retainForDays,
+			policyStorageRetainForOverridableKey:   retainForOverridable,
+			policyStorageDeleteAfterDaysKey:        deleteAfterDays,
+			policyStorageDeleteAfterOverridableKey: deleteAfterOverridable,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
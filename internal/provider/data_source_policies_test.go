@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooPoliciesDataSource = `
+data "boundary_policies" "foo" {
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_policy_storage.foo]
+}`
+
+func TestAccDataSourcePolicies(t *testing.T) {
+	if os.Getenv("BOUNDARY_TF_PROVIDER_TEST_SESSION_RECORDING") == "" {
+		t.Skip("Not running policies data source test without session recording support")
+	}
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, orgStoragePolicy, fooPoliciesDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.boundary_policies.foo", IDKey, "boundary_scope.org1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_policies.foo", "items.0.id", "boundary_policy_storage.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.0.type", "storage"),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.0.retain_for_days", "30"),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.0.retain_for_overridable", "false"),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.0.delete_after_days", "60"),
+					resource.TestCheckResourceAttr("data.boundary_policies.foo", "items.0.delete_after_overridable", "true"),
+				),
+			},
+		},
+	})
+}
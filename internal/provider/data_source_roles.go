@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: "The roles data source lists the roles of a scope with their principals and grants, optionally " +
+			"including the ones of its child scopes and filtered by the controller, e.g. to audit them or to add " +
+			"principals to existing roles with `boundary_role_principal`.",
+
+		ReadContext: dataSourceRolesRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the roles were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the roles of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the roles of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the roles must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The roles found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						roleGrantScopeIdsKey: {
+							Description: "The IDs of the scopes the grants of the role apply to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						rolePrincipalIdsKey: {
+							Description: "The IDs of the users and groups the role is assigned to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						roleGrantStringsKey: {
+							Description: "The grants of the role.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []roles.Option{roles.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, roles.WithFilter(filter))
+	}
+
+	rlr, err := rc.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing roles: %v", err)
+	}
+	if rlr == nil {
+		return diag.Errorf("role list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(rlr.Items))
+	for _, r := range rlr.Items {
+		// The controller leaves the principals and grants out of the listed
+		// roles
+		rrr, err := rc.Read(ctx, r.Id)
+		if err != nil {
+			return diag.Errorf("error reading role %q: %v", r.Id, err)
+		}
+		if rrr == nil {
+			return diag.Errorf("role nil after read")
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:                r.Id,
+			ScopeIdKey:           r.ScopeId,
+			NameKey:              r.Name,
+			DescriptionKey:       r.Description,
+			roleGrantScopeIdsKey: rrr.Item.GrantScopeIds,
+			rolePrincipalIdsKey:  rrr.Item.PrincipalIds,
+			roleGrantStringsKey:  rrr.Item.GrantStrings,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
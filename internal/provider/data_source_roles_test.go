@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooRolesDataSource = `
+resource "boundary_user" "foo" {
+	name       = "foo"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+resource "boundary_role" "auditors" {
+	name          = "auditors"
+	description   = "foo"
+	scope_id      = boundary_scope.org1.id
+	principal_ids = [boundary_user.foo.id]
+	grant_strings = ["ids=*;type=*;actions=read"]
+	depends_on    = [boundary_role.org1_admin]
+}
+
+data "boundary_roles" "auditors" {
+	scope_id   = boundary_scope.org1.id
+	filter     = "\"/item/name\" == \"auditors\""
+	depends_on = [boundary_role.auditors]
+}`
+
+func TestAccDataSourceRoles(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, fooRolesDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.boundary_roles.auditors", ScopeIdKey, "boundary_scope.org1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_roles.auditors", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_roles.auditors", "items.0.id", "boundary_role.auditors", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_roles.auditors", "items.0.name", "auditors"),
+					resource.TestCheckResourceAttr("data.boundary_roles.auditors", "items.0.description", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_roles.auditors", "items.0.principal_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_roles.auditors", "items.0.principal_ids.0", "boundary_user.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_roles.auditors", "items.0.grant_strings.#", "1"),
+					resource.TestCheckResourceAttr("data.boundary_roles.auditors", "items.0.grant_strings.0", "ids=*;type=*;actions=read"),
+				),
+			},
+		},
+	})
+}
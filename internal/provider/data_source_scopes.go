@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScopes() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scopes data source lists the child scopes of a scope, e.g. the projects of an org. When " +
+			"listing recursively, the `scope_id` of every item is the ID of its parent, so the tree of scopes can " +
+			"be rebuilt from the items.",
+
+		ReadContext: dataSourceScopesRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the child scopes were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the child scopes of. Defaults to the provider's `default_scope_id` if unset, or `global` if that isn't set either.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the child scopes of the child scopes too, e.g. all the orgs and projects when listing the global scope.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the scopes must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The scopes found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the scope.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the parent scope of the scope.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the scope.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the scope.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the scope, either `org` or `project`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScopesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		// Unlike the other resources, scopes have a natural root to list
+		scopeId = "global"
+	}
+
+	opts := []scopes.Option{scopes.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, scopes.WithFilter(filter))
+	}
+
+	slr, err := scp.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing scopes: %v", err)
+	}
+	if slr == nil {
+		return diag.Errorf("scope list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(slr.Items))
+	for _, s := range slr.Items {
+		items = append(items, map[string]interface{}{
+			IDKey:          s.Id,
+			ScopeIdKey:     s.ScopeId,
+			NameKey:        s.Name,
+			DescriptionKey: s.Description,
+			TypeKey:        s.Type,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooScopesDataSource = `
+resource "boundary_scope" "proj2" {
+	name       = "proj2"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+data "boundary_scopes" "org1" {
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_scope.proj1, boundary_scope.proj2]
+}
+
+data "boundary_scopes" "proj1" {
+	recursive  = true
+	filter     = "\"/item/name\" == \"proj1\""
+	depends_on = [boundary_scope.proj1, boundary_scope.proj2]
+}`
+
+func TestAccDataSourceScopes(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooScopesDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_scopes.org1", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_scopes.org1", ScopeIdKey, "boundary_scope.org1", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_scopes.proj1", ScopeIdKey, "global"),
+					resource.TestCheckResourceAttr("data.boundary_scopes.proj1", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_scopes.proj1", "items.0.id", "boundary_scope.proj1", IDKey),
+					resource.TestCheckResourceAttrPair("data.boundary_scopes.proj1", "items.0.scope_id", "boundary_scope.org1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_scopes.proj1", "items.0.name", "proj1"),
+					resource.TestCheckResourceAttr("data.boundary_scopes.proj1", "items.0.description", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_scopes.proj1", "items.0.type", "project"),
+				),
+			},
+		},
+	})
+}
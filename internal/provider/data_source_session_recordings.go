@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	sessionRecordingSessionIdKey     = "session_id"
+	sessionRecordingStateKey         = "state"
+	sessionRecordingStartTimeKey     = "start_time"
+	sessionRecordingEndTimeKey       = "end_time"
+	sessionRecordingDurationKey      = "duration"
+	sessionRecordingStartedAfterKey  = "started_after"
+	sessionRecordingStartedBeforeKey = "started_before"
+)
+
+func dataSourceSessionRecordings() *schema.Resource {
+	return &schema.Resource{
+		Description: "The session recordings data source lists the session recordings of a scope, optionally only " +
+			"the ones of a target or of a user, or started in a time range, e.g. to check that the sessions of a " +
+			"target are recorded.",
+
+		ReadContext: dataSourceSessionRecordingsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the session recordings were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the session recordings of, either global or an org." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the session recordings of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			targetIdKey: {
+				Description: "The ID of the target the sessions must have been recorded for.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			userIdKey: {
+				Description: "The ID of the user the sessions must have been recorded of.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			sessionRecordingStartedAfterKey: {
+				Description:  "The time, in RFC 3339 format, the recordings must have started after.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			sessionRecordingStartedBeforeKey: {
+				Description:  "The time, in RFC 3339 format, the recordings must have started before.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the session recordings must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The session recordings found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the session recording.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the session recording.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingStateKey: {
+							Description: "The state of the recording, e.g. `started`, `available` or `unknown`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingSessionIdKey: {
+							Description: "The ID of the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetStorageBucketIdKey: {
+							Description: "The ID of the storage bucket the recording is stored in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetIdKey: {
+							Description: "The ID of the target of the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						userIdKey: {
+							Description: "The ID of the user of the recorded session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingStartTimeKey: {
+							Description: "The time the recording started.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingEndTimeKey: {
+							Description: "The time the recording ended, empty while the session is still recorded.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionRecordingDurationKey: {
+							Description: "The duration of the recording, e.g. `1.5s`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sessionRecordingStartedWithin reports whether the recording started in the
+// time range, leaving the range open on the sides that are empty.
+func sessionRecordingStartedWithin(startTime, after, before string) (bool, error) {
+	if after == "" && before == "" {
+		return true, nil
+	}
+	start, err := time.Parse(time.RFC3339Nano, startTime)
+	if err != nil {
+		return false, err
+	}
+	for _, bound := range []struct {
+		value string
+		ok    func(time.Time) bool
+	}{
+		{after, start.After},
+		{before, start.Before},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			return false, err
+		}
+		if !bound.ok(t) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func dataSourceSessionRecordingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	srClient := sessionrecordings.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []sessionrecordings.Option{sessionrecordings.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	filter := combineFilter(
+		d.Get(dataSourceFilterKey).(string),
+		filterCondition{"/item/create_time_values/target/id", d.Get(targetIdKey).(string)},
+		filterCondition{"/item/create_time_values/user/id", d.Get(userIdKey).(string)},
+	)
+	if filter != "" {
+		opts = append(opts, sessionrecordings.WithFilter(filter))
+	}
+
+	srlr, err := srClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing session recordings: %v", err)
+	}
+	if srlr == nil {
+		return diag.Errorf("session recording list nil after list")
+	}
+
+	// The values the recording was created with are nested in the response,
+	// so the raw items are used rather than the typed ones
+	rawItems, _ := srlr.GetResponse().Map["items"].([]interface{})
+	after := d.Get(sessionRecordingStartedAfterKey).(string)
+	before := d.Get(sessionRecordingStartedBeforeKey).(string)
+	items := make([]interface{}, 0, len(rawItems))
+	for _, ri := range rawItems {
+		raw, ok := ri.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		startTime, _ := raw["start_time"].(string)
+		within, err := sessionRecordingStartedWithin(startTime, after, before)
+		if err != nil {
+			return diag.Errorf("error checking start time of session recording %q: %v", raw["id"], err)
+		}
+		if !within {
+			continue
+		}
+
+		createTimeValues, _ := raw["create_time_values"].(map[string]interface{})
+		target, _ := createTimeValues["target"].(map[string]interface{})
+		user, _ := createTimeValues["user"].(map[string]interface{})
+		scope, _ := raw["scope"].(map[string]interface{})
+		items = append(items, map[string]interface{}{
+			IDKey:                        raw["id"],
+			ScopeIdKey:                   scope["id"],
+			TypeKey:                      raw["type"],
+			sessionRecordingStateKey:     raw["state"],
+			sessionRecordingSessionIdKey: raw["session_id"],
+			targetStorageBucketIdKey:     raw["storage_bucket_id"],
+			targetIdKey:                  target["id"],
+			userIdKey:                    user["id"],
+			sessionRecordingStartTimeKey: startTime,
+			sessionRecordingEndTimeKey:   raw["end_time"],
+			sessionRecordingDurationKey:  raw["duration"],
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
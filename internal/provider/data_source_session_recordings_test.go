@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fooSessionRecordingsDataSource = `
+data "boundary_session_recordings" "foo" {
+	scope_id      = "global"
+	recursive     = true
+	user_id       = "u_auth"
+	started_after = "2024-01-01T00:00:00Z"
+}`
+
+// Session recordings need an enterprise controller.
+func TestAccDataSourceSessionRecordings(t *testing.T) {
+	if os.Getenv("BOUNDARY_TF_PROVIDER_TEST_SESSION_RECORDING") == "" {
+		t.Skip("Not running session recordings test without session recording support")
+	}
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooSessionRecordingsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_session_recordings.foo", IDKey, "global"),
+					resource.TestCheckResourceAttr("data.boundary_session_recordings.foo", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestSessionRecordingStartedWithin(t *testing.T) {
+	const startTime = "2024-06-15T12:00:00.123456Z"
+
+	cases := []struct {
+		name          string
+		after, before string
+		want          bool
+		wantErr       bool
+	}{
+		{name: "no range", want: true},
+		{name: "after", after: "2024-06-01T00:00:00Z", want: true},
+		{name: "not after", after: "2024-07-01T00:00:00Z", want: false},
+		{name: "before", before: "2024-07-01T00:00:00Z", want: true},
+		{name: "not before", before: "2024-06-01T00:00:00Z", want: false},
+		{name: "within", after: "2024-06-15T11:00:00Z", before: "2024-06-15T13:00:00+00:00", want: true},
+		{name: "time zone", after: "2024-06-15T13:00:00+02:00", want: true},
+		{name: "invalid bound", after: "yesterday", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sessionRecordingStartedWithin(startTime, tc.after, tc.before)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	sessionStatusKey         = "status"
+	sessionHostIdKey         = "host_id"
+	sessionHostSetIdKey      = "host_set_id"
+	sessionCreatedTimeKey    = "created_time"
+	sessionExpirationTimeKey = "expiration_time"
+
+	sessionStatusPending    = "pending"
+	sessionStatusActive     = "active"
+	sessionStatusCanceling  = "canceling"
+	sessionStatusTerminated = "terminated"
+)
+
+func dataSourceSessions() *schema.Resource {
+	return &schema.Resource{
+		Description: "The sessions data source lists the sessions of a scope, optionally only the ones of a target, " +
+			"of a user or with a status, e.g. to check for active sessions before destroying a target. Terminated " +
+			"sessions are only listed when `status` is `terminated`.",
+
+		ReadContext: dataSourceSessionsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the sessions were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the sessions of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the sessions of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			targetIdKey: {
+				Description: "The ID of the target the sessions must be for.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			userIdKey: {
+				Description: "The ID of the user the sessions must be of.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			sessionStatusKey: {
+				Description: "The status the sessions must have, one of `pending`, `active`, `canceling` or `terminated`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sessionStatusPending,
+					sessionStatusActive,
+					sessionStatusCanceling,
+					sessionStatusTerminated,
+				}, false),
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the sessions must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The sessions found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionStatusKey: {
+							Description: "The status of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetIdKey: {
+							Description: "The ID of the target of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						userIdKey: {
+							Description: "The ID of the user of the session.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionHostIdKey: {
+							Description: "The ID of the host of the session, if the target has host sources.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionHostSetIdKey: {
+							Description: "The ID of the host set of the session, if the target has host sources.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionCreatedTimeKey: {
+							Description: "The time the session was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						sessionExpirationTimeKey: {
+							Description: "The time the session expires.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sessionsFilter combines the filter of the data source with the conditions
+// on the target, the user and the status of the sessions.
+func sessionsFilter(filter, targetId, userId, status string) string {
+	return combineFilter(filter,
+		filterCondition{"/item/target_id", targetId},
+		filterCondition{"/item/user_id", userId},
+		filterCondition{"/item/status", status},
+	)
+}
+
+// formatSessionTime formats a time of a session, leaving it empty when unset.
+func formatSessionTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func dataSourceSessionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	sClient := sessions.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := d.Get(sessionStatusKey).(string)
+	opts := []sessions.Option{
+		sessions.WithRecursive(d.Get(dataSourceRecursiveKey).(bool)),
+		sessions.WithIncludeTerminated(status == sessionStatusTerminated),
+	}
+	filter := sessionsFilter(
+		d.Get(dataSourceFilterKey).(string),
+		d.Get(targetIdKey).(string),
+		d.Get(userIdKey).(string),
+		status,
+	)
+	if filter != "" {
+		opts = append(opts, sessions.WithFilter(filter))
+	}
+
+	slr, err := sClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing sessions: %v", err)
+	}
+	if slr == nil {
+		return diag.Errorf("session list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(slr.Items))
+	for _, s := range slr.Items {
+		items = append(items, map[string]interface{}{
+			IDKey:                    s.Id,
+			ScopeIdKey:               s.ScopeId,
+			TypeKey:                  s.Type,
+			sessionStatusKey:         s.Status,
+			targetIdKey:              s.TargetId,
+			userIdKey:                s.UserId,
+			sessionHostIdKey:         s.HostId,
+			sessionHostSetIdKey:      s.HostSetId,
+			sessionCreatedTimeKey:    formatSessionTime(s.CreatedTime),
+			sessionExpirationTimeKey: formatSessionTime(s.ExpirationTime),
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const fooSessionsDataSource = `
+resource "boundary_target" "foo" {
+	name         = "foo"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "10.0.0.1"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}
+
+data "boundary_sessions" "foo" {
+	scope_id  = boundary_scope.proj1.id
+	target_id = boundary_target.foo.id
+	status    = "active"
+}`
+
+func TestAccDataSourceSessions(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// The test controller has no workers, so no sessions can be
+				// established
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSessionsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.boundary_sessions.foo", ScopeIdKey, "boundary_scope.proj1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_sessions.foo", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestSessionsFilter(t *testing.T) {
+	cases := []struct {
+		name                             string
+		filter, targetId, userId, status string
+		want                             string
+	}{
+		{
+			name: "nothing",
+			want: "",
+		},
+		{
+			name:   "filter only",
+			filter: `"/item/type" == "tcp"`,
+			want:   `"/item/type" == "tcp"`,
+		},
+		{
+			name:     "target",
+			targetId: "ttcp_1234567890",
+			want:     `"/item/target_id" == "ttcp_1234567890"`,
+		},
+		{
+			name:   "user and status",
+			userId: "u_1234567890",
+			status: "active",
+			want:   `"/item/user_id" == "u_1234567890" and "/item/status" == "active"`,
+		},
+		{
+			name:     "everything",
+			filter:   `"/item/type" == "tcp" or "/item/type" == "ssh"`,
+			targetId: "ttcp_1234567890",
+			userId:   "u_1234567890",
+			status:   "active",
+			want:     `"/item/target_id" == "ttcp_1234567890" and "/item/user_id" == "u_1234567890" and "/item/status" == "active" and ("/item/type" == "tcp" or "/item/type" == "ssh")`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, sessionsFilter(tc.filter, tc.targetId, tc.userId, tc.status))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/storagebuckets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceStorageBuckets() *schema.Resource {
+	return &schema.Resource{
+		Description: "The storage buckets data source lists the storage buckets of a scope, optionally including " +
+			"the ones of its child scopes and filtered by the controller, so targets recording sessions can " +
+			"reference storage buckets managed elsewhere.",
+
+		ReadContext: dataSourceStorageBucketsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the storage buckets were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the storage buckets of, either global or an org." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the storage buckets of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the storage buckets must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The storage buckets found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the storage bucket.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the storage bucket.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the storage bucket.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the storage bucket.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						PluginIdKey: {
+							Description: "The ID of the plugin backing the storage bucket.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						PluginNameKey: {
+							Description: "The name of the plugin backing the storage bucket, e.g. `aws` or `minio`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						storageBucketBucketNameKey: {
+							Description: "The name of the bucket within the external object store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						storageBucketBucketPrefixKey: {
+							Description: "The prefix used to organize the data held within the external object store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						storageBucketWorkerFilterKey: {
+							Description: "The filter of the workers allowed to access the bucket.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	sbClient := storagebuckets.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []storagebuckets.Option{storagebuckets.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, storagebuckets.WithFilter(filter))
+	}
+
+	sblr, err := sbClient.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing storage buckets: %v", err)
+	}
+	if sblr == nil {
+		return diag.Errorf("storage bucket list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(sblr.Items))
+	for _, sb := range sblr.Items {
+		var pluginName string
+		if sb.Plugin != nil {
+			pluginName = sb.Plugin.Name
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:                        sb.Id,
+			ScopeIdKey:                   sb.ScopeId,
+			NameKey:                      sb.Name,
+			DescriptionKey:               sb.Description,
+			PluginIdKey:                  sb.PluginId,
+			PluginNameKey:                pluginName,
+			storageBucketBucketNameKey:   sb.BucketName,
+			storageBucketBucketPrefixKey: sb.BucketPrefix,
+			storageBucketWorkerFilterKey: sb.WorkerFilter,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
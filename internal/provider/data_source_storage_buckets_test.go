@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooStorageBucketsDataSource = `
+data "boundary_storage_buckets" "foo" {
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_storage_bucket.foo]
+}
+
+data "boundary_storage_buckets" "none" {
+	scope_id   = boundary_scope.org1.id
+	filter     = "\"/item/name\" == \"bar\""
+	depends_on = [boundary_storage_bucket.foo]
+}`
+
+func TestAccDataSourceStorageBuckets(t *testing.T) {
+	bucketName := os.Getenv("BOUNDARY_TF_PROVIDER_TEST_STORAGE_BUCKET_NAME")
+	region := os.Getenv("BOUNDARY_TF_PROVIDER_TEST_STORAGE_BUCKET_REGION")
+	if bucketName == "" || region == "" {
+		t.Skip("Not running storage bucket test without a storage bucket name and region")
+	}
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, storageBucketConfig(bucketName, region, storageBucketDesc, false), fooStorageBucketsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.boundary_storage_buckets.foo", IDKey, "boundary_scope.org1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.foo", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_storage_buckets.foo", "items.0.id", "boundary_storage_bucket.foo", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.foo", "items.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.foo", "items.0.description", storageBucketDesc),
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.foo", "items.0.plugin_name", "aws"),
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.foo", "items.0.bucket_name", bucketName),
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.foo", "items.0.bucket_prefix", "recordings"),
+
+					resource.TestCheckResourceAttr("data.boundary_storage_buckets.none", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
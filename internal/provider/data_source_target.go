@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	targetOrgNameKey     = "org_name"
+	targetProjectNameKey = "project_name"
+)
+
+func dataSourceTarget() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target data source looks up a single target by its name, in a project given either by " +
+			"its ID or by the names of the org and the project, so targets managed elsewhere can be referenced.",
+
+		ReadContext: dataSourceTargetRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The name of the target.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the project of the target. Conflicts with `org_name` and `project_name`." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					targetOrgNameKey,
+					targetProjectNameKey,
+				},
+			},
+			targetOrgNameKey: {
+				Description:  "The name of the org of the project of the target. Must be set together with `project_name`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{targetProjectNameKey},
+			},
+			targetProjectNameKey: {
+				Description:  "The name of the project of the target. Must be set together with `org_name`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{targetOrgNameKey},
+			},
+			DescriptionKey: {
+				Description: "The description of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			TypeKey: {
+				Description: "The type of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetAddressKey: {
+				Description: "The address of the target, when it has one instead of host sources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetDefaultPortKey: {
+				Description: "The default port of the target.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			targetDefaultClientPortKey: {
+				Description: "The default client port of the target.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			targetHostSourceIdsKey: {
+				Description: "The IDs of the host sources of the target.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			targetBrokeredCredentialSourceIdsKey: {
+				Description: "The IDs of the credential sources brokered to the users connecting to the target.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			targetInjectedAppCredentialSourceIdsKey: {
+				Description: "The IDs of the credential sources injected into the sessions of the target.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			targetSessionMaxSecondsKey: {
+				Description: "The maximum duration of the sessions of the target, in seconds.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			targetSessionConnectionLimitKey: {
+				Description: "The maximum number of connections per session of the target, -1 meaning unlimited.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			targetWorkerFilterKey: {
+				Description: "The worker filter of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetEgressWorkerFilterKey: {
+				Description: "The egress worker filter of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetIngressWorkerFilterKey: {
+				Description: "The ingress worker filter of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetEnableSessionRecordingKey: {
+				Description: "Whether the sessions of the target are recorded.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			targetStorageBucketIdKey: {
+				Description: "The ID of the storage bucket the session recordings of the target are stored in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	var scopeId string
+	if orgName := d.Get(targetOrgNameKey).(string); orgName != "" {
+		orgId, err := findScopeIdByName(ctx, md, "global", orgName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		scopeId, err = findScopeIdByName(ctx, md, orgId, d.Get(targetProjectNameKey).(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		var err error
+		scopeId, err = dataSourceScopeId(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	name := d.Get(NameKey).(string)
+	tlr, err := tc.List(ctx, scopeId, targets.WithFilter(dataSourceNameFilter(name)))
+	if err != nil {
+		return diag.Errorf("error listing targets: %v", err)
+	}
+	if tlr == nil || len(tlr.Items) == 0 {
+		return diag.Errorf("no target named %q found in scope %q", name, scopeId)
+	}
+	if len(tlr.Items) > 1 {
+		return diag.Errorf("%d targets named %q found in scope %q", len(tlr.Items), name, scopeId)
+	}
+
+	// The items of the list may leave out some fields, read the target to get
+	// all of them
+	trr, err := tc.Read(ctx, tlr.Items[0].Id)
+	if err != nil {
+		return diag.Errorf("error reading target: %v", err)
+	}
+	if trr == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	if err := setFromTargetResponseMap(d, trr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooTargetDataSource = `
+resource "boundary_target" "foo" {
+	name                     = "foo"
+	description              = "bar"
+	type                     = "tcp"
+	scope_id                 = boundary_scope.proj1.id
+	address                  = "10.0.0.1"
+	default_port             = 22
+	session_connection_limit = 5
+	depends_on               = [boundary_role.proj1_admin]
+}
+
+data "boundary_target" "by_names" {
+	name         = "foo"
+	org_name     = "org1"
+	project_name = "proj1"
+	depends_on   = [boundary_target.foo]
+}
+
+data "boundary_target" "by_scope_id" {
+	name       = "foo"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_target.foo]
+}`
+
+func TestAccDataSourceTarget(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.boundary_target.by_names", IDKey, "boundary_target.foo", IDKey),
+					resource.TestCheckResourceAttrPair("data.boundary_target.by_names", ScopeIdKey, "boundary_scope.proj1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_target.by_names", DescriptionKey, "bar"),
+					resource.TestCheckResourceAttr("data.boundary_target.by_names", TypeKey, targetTypeTcp),
+					resource.TestCheckResourceAttr("data.boundary_target.by_names", targetAddressKey, "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.boundary_target.by_names", targetDefaultPortKey, "22"),
+					resource.TestCheckResourceAttr("data.boundary_target.by_names", targetSessionConnectionLimitKey, "5"),
+
+					resource.TestCheckResourceAttrPair("data.boundary_target.by_scope_id", IDKey, "boundary_target.foo", IDKey),
+				),
+			},
+		},
+	})
+}
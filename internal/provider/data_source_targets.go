@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTargets() *schema.Resource {
+	return &schema.Resource{
+		Description: "The targets data source lists the targets of a scope, optionally including the ones of its " +
+			"child scopes and filtered by the controller, so targets managed elsewhere can be referenced.",
+
+		ReadContext: dataSourceTargetsRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the targets were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the targets of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the targets of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the targets must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The targets found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the target.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the target.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the target.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the target.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the target.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetAddressKey: {
+							Description: "The address of the target, when it has one instead of host sources.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetHostSourceIdsKey: {
+							Description: "The IDs of the host sources of the target.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []targets.Option{targets.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, targets.WithFilter(filter))
+	}
+
+	tlr, err := tc.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing targets: %v", err)
+	}
+	if tlr == nil {
+		return diag.Errorf("target list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(tlr.Items))
+	for _, t := range tlr.Items {
+		items = append(items, map[string]interface{}{
+			IDKey:                  t.Id,
+			ScopeIdKey:             t.ScopeId,
+			NameKey:                t.Name,
+			DescriptionKey:         t.Description,
+			TypeKey:                t.Type,
+			targetAddressKey:       t.Address,
+			targetHostSourceIdsKey: t.HostSourceIds,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooTargetsDataSource = `
+resource "boundary_target" "prod" {
+	name         = "prod-db"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "10.0.0.1"
+	default_port = 5432
+	depends_on   = [boundary_role.proj1_admin]
+}
+
+resource "boundary_target" "dev" {
+	name         = "dev-db"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "10.0.0.2"
+	default_port = 5432
+	depends_on   = [boundary_role.proj1_admin]
+}
+
+data "boundary_targets" "all" {
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_target.prod, boundary_target.dev]
+}
+
+data "boundary_targets" "prod" {
+	scope_id   = boundary_scope.org1.id
+	recursive  = true
+	filter     = "\"/item/name\" matches \"prod-.*\""
+	depends_on = [boundary_target.prod, boundary_target.dev]
+}`
+
+func TestAccDataSourceTargets(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetsDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_targets.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_targets.all", ScopeIdKey, "boundary_scope.proj1", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_targets.prod", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_targets.prod", "items.0.id", "boundary_target.prod", IDKey),
+					resource.TestCheckResourceAttrPair("data.boundary_targets.prod", "items.0.scope_id", "boundary_scope.proj1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_targets.prod", "items.0.name", "prod-db"),
+					resource.TestCheckResourceAttr("data.boundary_targets.prod", "items.0.type", targetTypeTcp),
+					resource.TestCheckResourceAttr("data.boundary_targets.prod", "items.0.address", "10.0.0.1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	userLoginNameKey        = "login_name"
+	userFullNameKey         = "full_name"
+	userEmailKey            = "email"
+	userPrimaryAccountIdKey = "primary_account_id"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "The users data source lists the users of a scope, optionally including the ones of its child " +
+			"scopes and filtered by the controller, e.g. on the email of their primary account, so existing users " +
+			"can be used as the principals of roles.",
+
+		ReadContext: dataSourceUsersRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the users were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to list the users of." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			dataSourceRecursiveKey: {
+				Description: "Whether to list the users of the child scopes too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the users must match. The details of the primary account are available as " +
+					"`/item/login_name`, `/item/full_name` and `/item/email`." + dataSourceFilterDescription,
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			dataSourceItemsKey: {
+				Description: "The users found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						ScopeIdKey: {
+							Description: "The ID of the scope of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						userPrimaryAccountIdKey: {
+							Description: "The ID of the primary account of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						userLoginNameKey: {
+							Description: "The login name of the primary account of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						userFullNameKey: {
+							Description: "The full name of the primary account of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						userEmailKey: {
+							Description: "The email of the primary account of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	scopeId, err := dataSourceScopeId(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := []users.Option{users.WithRecursive(d.Get(dataSourceRecursiveKey).(bool))}
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, users.WithFilter(filter))
+	}
+
+	ulr, err := usrs.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing users: %v", err)
+	}
+	if ulr == nil {
+		return diag.Errorf("user list nil after list")
+	}
+
+	items := make([]interface{}, 0, len(ulr.Items))
+	for _, u := range ulr.Items {
+		items = append(items, map[string]interface{}{
+			IDKey:                   u.Id,
+			ScopeIdKey:              u.ScopeId,
+			NameKey:                 u.Name,
+			DescriptionKey:          u.Description,
+			userPrimaryAccountIdKey: u.PrimaryAccountId,
+			userLoginNameKey:        u.LoginName,
+			userFullNameKey:         u.FullName,
+			userEmailKey:            u.Email,
+		})
+	}
+
+	if err := d.Set(ScopeIdKey, scopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fooUsersDataSource = `
+resource "boundary_user" "alice" {
+	name        = "alice"
+	description = "foo"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+}
+
+resource "boundary_user" "bob" {
+	name       = "bob"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+data "boundary_users" "all" {
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_user.alice, boundary_user.bob]
+}
+
+data "boundary_users" "alice" {
+	scope_id   = boundary_scope.org1.id
+	filter     = "\"/item/name\" == \"alice\""
+	depends_on = [boundary_user.alice, boundary_user.bob]
+}`
+
+func TestAccDataSourceUsers(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, fooUsersDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_users.all", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.boundary_users.all", ScopeIdKey, "boundary_scope.org1", IDKey),
+
+					resource.TestCheckResourceAttr("data.boundary_users.alice", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_users.alice", "items.0.id", "boundary_user.alice", IDKey),
+					resource.TestCheckResourceAttrPair("data.boundary_users.alice", "items.0.scope_id", "boundary_scope.org1", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_users.alice", "items.0.name", "alice"),
+					resource.TestCheckResourceAttr("data.boundary_users.alice", "items.0.description", "foo"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	dataSourceWorkersTagsKey = "tags"
+	workerLastStatusTimeKey  = "last_status_time"
+)
+
+func dataSourceWorkers() *schema.Resource {
+	return &schema.Resource{
+		Description: "The workers data source lists the workers, optionally only the ones with the given tags or " +
+			"matching a filter evaluated by the controller, e.g. to check which workers a `worker_filter` selects.",
+
+		ReadContext: dataSourceWorkersRead,
+		Timeouts:    dataSourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the workers were listed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope to list the workers of. Defaults to `global`, the only scope workers can be created in.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+			},
+			dataSourceWorkersTagsKey: {
+				Description: "The tags the workers must have, mapping each tag key to one of the values the worker must have for it.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			dataSourceFilterKey: {
+				Description: "A Boolean expression the workers must match." + dataSourceFilterDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dataSourceItemsKey: {
+				Description: "The workers found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the worker.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the worker.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						DescriptionKey: {
+							Description: "The description of the worker.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the worker, either `pki` or `kms`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						address: {
+							Description: "The address of the worker.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						releaseVersion: {
+							Description: "The version of the Boundary binary running on the worker.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						workerLastStatusTimeKey: {
+							Description: "The last time the worker reported its status to the controller, empty if it never did.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// workerHasTags reports whether the canonical tags of a worker have one of
+// the values of each of the given tags.
+func workerHasTags(canonicalTags map[string][]string, tags map[string]interface{}) bool {
+	for k, v := range tags {
+		found := false
+		for _, value := range canonicalTags[k] {
+			if value == v.(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceWorkersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	wkrs := workers.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+
+	var opts []workers.Option
+	if filter := d.Get(dataSourceFilterKey).(string); filter != "" {
+		opts = append(opts, workers.WithFilter(filter))
+	}
+
+	wlr, err := wkrs.List(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error listing workers: %v", err)
+	}
+	if wlr == nil {
+		return diag.Errorf("worker list nil after list")
+	}
+
+	tags := d.Get(dataSourceWorkersTagsKey).(map[string]interface{})
+	items := make([]interface{}, 0, len(wlr.Items))
+	for _, w := range wlr.Items {
+		if !workerHasTags(w.CanonicalTags, tags) {
+			continue
+		}
+		var lastStatusTime string
+		if !w.LastStatusTime.IsZero() {
+			lastStatusTime = w.LastStatusTime.Format(time.RFC3339)
+		}
+		items = append(items, map[string]interface{}{
+			IDKey:                   w.Id,
+			NameKey:                 w.Name,
+			DescriptionKey:          w.Description,
+			TypeKey:                 w.Type,
+			address:                 w.Address,
+			releaseVersion:          w.ReleaseVersion,
+			workerLastStatusTimeKey: lastStatusTime,
+		})
+	}
+
+	if err := d.Set(dataSourceItemsKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(scopeId)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const fooWorkersDataSource = `
+data "boundary_workers" "us_east" {
+	tags = {
+		region = "us-east-1"
+	}
+	depends_on = [boundary_worker_tags.foo]
+}
+
+data "boundary_workers" "prod_database" {
+	tags = {
+		type = "database"
+	}
+	filter     = "\"/item/name\" == \"tagged worker\""
+	depends_on = [boundary_worker_tags.foo]
+}
+
+data "boundary_workers" "us_west" {
+	tags = {
+		region = "us-west-2"
+	}
+	depends_on = [boundary_worker_tags.foo]
+}`
+
+func TestAccDataSourceWorkers(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, workerTagsCreate, fooWorkersDataSource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.boundary_workers.us_east", IDKey, "global"),
+					resource.TestCheckResourceAttr("data.boundary_workers.us_east", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.boundary_workers.us_east", "items.0.id", "boundary_worker.controller_led", IDKey),
+					resource.TestCheckResourceAttr("data.boundary_workers.us_east", "items.0.name", "tagged worker"),
+					resource.TestCheckResourceAttr("data.boundary_workers.us_east", "items.0.type", "pki"),
+					resource.TestCheckResourceAttr("data.boundary_workers.us_east", "items.0.last_status_time", ""),
+
+					resource.TestCheckResourceAttr("data.boundary_workers.prod_database", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.boundary_workers.us_west", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkerHasTags(t *testing.T) {
+	canonicalTags := map[string][]string{
+		"region": {"us-east-1"},
+		"type":   {"prod", "database"},
+	}
+
+	cases := []struct {
+		name string
+		tags map[string]interface{}
+		want bool
+	}{
+		{name: "no tags", tags: map[string]interface{}{}, want: true},
+		{name: "single value", tags: map[string]interface{}{"region": "us-east-1"}, want: true},
+		{name: "one of several values", tags: map[string]interface{}{"type": "database"}, want: true},
+		{name: "several tags", tags: map[string]interface{}{"region": "us-east-1", "type": "prod"}, want: true},
+		{name: "other value", tags: map[string]interface{}{"region": "us-west-2"}, want: false},
+		{name: "missing key", tags: map[string]interface{}{"team": "ops"}, want: false},
+		{name: "one tag missing", tags: map[string]interface{}{"region": "us-east-1", "team": "ops"}, want: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, workerHasTags(canonicalTags, tc.tags))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultScopeIdDescription is appended to the description of the scope_id
+// attribute of every resource that falls back to the provider's
+// default_scope_id.
+const defaultScopeIdDescription = " Defaults to the provider's `default_scope_id` if unset."
+
+// customizeDiffDefaultScopeId fills in scope_id from the provider's
+// default_scope_id when it is not set in the resource configuration. Setting
+// it during the plan, rather than at create time, means a change of the
+// provider default shows up as a replacement of the resource.
+func customizeDiffDefaultScopeId(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || !rawConfig.GetAttr(ScopeIdKey).IsNull() {
+		return nil
+	}
+
+	var defaultScopeId string
+	if md, ok := meta.(*metaData); ok && md != nil {
+		defaultScopeId = md.defaultScopeId
+	}
+	if defaultScopeId == "" {
+		if d.Id() == "" {
+			return errors.New(`"scope_id" must be set when the provider has no "default_scope_id"`)
+		}
+		// Keep the scope the resource was created in
+		return nil
+	}
+	return d.SetNew(ScopeIdKey, defaultScopeId)
+}
+
+// dataSourceScopeId returns the scope_id of a data source, falling back to the
+// provider's default_scope_id when it is not set.
+func dataSourceScopeId(d *schema.ResourceData, meta interface{}) (string, error) {
+	if scopeId := d.Get(ScopeIdKey).(string); scopeId != "" {
+		return scopeId, nil
+	}
+	if md, ok := meta.(*metaData); ok && md != nil && md.defaultScopeId != "" {
+		return md.defaultScopeId, nil
+	}
+	return "", errors.New(`"scope_id" must be set when the provider has no "default_scope_id"`)
+}
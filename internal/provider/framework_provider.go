@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the resources and data sources written against the
+// plugin framework. It is muxed together with the SDKv2 provider and shares
+// its configuration, so both halves talk to Boundary through the same client.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ fwprovider.Provider = (*frameworkProvider)(nil)
+
+// NewFrameworkProvider returns the framework half of the provider. The given
+// SDKv2 provider must be served by the same mux server, ahead of this one, so
+// it is configured first.
+func NewFrameworkProvider(sdkProvider *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "boundary"
+}
+
+// Schema returns the provider schema of the SDKv2 provider, since the mux
+// server requires both providers to declare exactly the same one.
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	s, diags := frameworkProviderSchema(p.sdkProvider.Schema)
+	resp.Schema = s
+	resp.Diagnostics.Append(diags...)
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	// The configuration was already processed by the SDKv2 provider
+	md, ok := p.sdkProvider.Meta().(*metaData)
+	if !ok {
+		return
+	}
+	resp.DataSourceData = md
+	resp.ResourceData = md
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// frameworkProviderSchema translates the SDKv2 provider schema to the
+// framework's representation.
+func frameworkProviderSchema(sdkSchema map[string]*schema.Schema) (fwschema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(sdkSchema))
+	for name := range sdkSchema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := make(map[string]fwschema.Attribute, len(sdkSchema))
+	for _, name := range names {
+		s := sdkSchema[name]
+		var a fwschema.Attribute
+		switch s.Type {
+		case schema.TypeString:
+			a = fwschema.StringAttribute{
+				MarkdownDescription: s.Description,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+				DeprecationMessage:  s.Deprecated,
+			}
+		case schema.TypeBool:
+			a = fwschema.BoolAttribute{
+				MarkdownDescription: s.Description,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+				DeprecationMessage:  s.Deprecated,
+			}
+		case schema.TypeInt:
+			a = fwschema.Int64Attribute{
+				MarkdownDescription: s.Description,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+				DeprecationMessage:  s.Deprecated,
+			}
+		case schema.TypeFloat:
+			a = fwschema.Float64Attribute{
+				MarkdownDescription: s.Description,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+				DeprecationMessage:  s.Deprecated,
+			}
+		case schema.TypeList, schema.TypeSet, schema.TypeMap:
+			elemType, err := frameworkElemType(s.Elem)
+			if err != nil {
+				diags.AddError("Unsupported provider attribute", fmt.Sprintf("%q: %s", name, err))
+				continue
+			}
+			switch s.Type {
+			case schema.TypeList:
+				a = fwschema.ListAttribute{
+					ElementType:         elemType,
+					MarkdownDescription: s.Description,
+					Required:            s.Required,
+					Optional:            s.Optional,
+					Sensitive:           s.Sensitive,
+					DeprecationMessage:  s.Deprecated,
+				}
+			case schema.TypeSet:
+				a = fwschema.SetAttribute{
+					ElementType:         elemType,
+					MarkdownDescription: s.Description,
+					Required:            s.Required,
+					Optional:            s.Optional,
+					Sensitive:           s.Sensitive,
+					DeprecationMessage:  s.Deprecated,
+				}
+			default:
+				a = fwschema.MapAttribute{
+					ElementType:         elemType,
+					MarkdownDescription: s.Description,
+					Required:            s.Required,
+					Optional:            s.Optional,
+					Sensitive:           s.Sensitive,
+					DeprecationMessage:  s.Deprecated,
+				}
+			}
+		default:
+			diags.AddError("Unsupported provider attribute", fmt.Sprintf("%q has unsupported type %s", name, s.Type))
+			continue
+		}
+		attributes[name] = a
+	}
+
+	return fwschema.Schema{Attributes: attributes}, diags
+}
+
+// frameworkElemType returns the framework type of the elements of a list, set
+// or map attribute. Only primitive elements are supported.
+func frameworkElemType(elem interface{}) (attr.Type, error) {
+	s, ok := elem.(*schema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("only primitive elements are supported")
+	}
+	switch s.Type {
+	case schema.TypeString:
+		return types.StringType, nil
+	case schema.TypeBool:
+		return types.BoolType, nil
+	case schema.TypeInt:
+		return types.Int64Type, nil
+	case schema.TypeFloat:
+		return types.Float64Type, nil
+	default:
+		return nil, fmt.Errorf("unsupported element type %s", s.Type)
+	}
+}
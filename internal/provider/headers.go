@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Version is the version of the provider reported in the user agent. It is
+// set by main from the version injected at build time.
+var Version = "dev"
+
+// headerTransport sets headers the provider attaches to every request sent to
+// the controller.
+type headerTransport struct {
+	wrapped http.RoundTripper
+	headers http.Header
+}
+
+func newHeaderTransport(wrapped http.RoundTripper, headers http.Header) *headerTransport {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &headerTransport{wrapped: wrapped, headers: headers}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header[k] = v
+	}
+	return t.wrapped.RoundTrip(req)
+}
+
+// userAgent returns the user agent the provider identifies itself with,
+// including the Terraform version and any suffix from "user_agent_extra".
+func userAgent(p *schema.Provider, d *schema.ResourceData) string {
+	ua := p.UserAgent("terraform-provider-boundary", Version)
+	if extra := strings.TrimSpace(d.Get("user_agent_extra").(string)); extra != "" {
+		ua += " " + extra
+	}
+	return ua
+}
+
+// customHeaders returns the headers from the "headers" provider attribute.
+func customHeaders(d *schema.ResourceData) (http.Header, error) {
+	headers := http.Header{}
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		if http.CanonicalHeaderKey(k) == "Authorization" {
+			return nil, fmt.Errorf(`the %q header can't be set in "headers", it carries the Boundary token`, k)
+		}
+		headers.Set(k, v.(string))
+	}
+	return headers, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAgent(t *testing.T) {
+	var gotUserAgent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+	}))
+	defer srv.Close()
+
+	p := New()
+	p.TerraformVersion = "1.3.0"
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"user_agent_extra": "pipeline/deploy-prod",
+	})
+	ua := userAgent(p, d)
+	assert.True(t, strings.HasPrefix(ua, "Terraform/1.3.0 "), ua)
+	assert.True(t, strings.HasSuffix(ua, " terraform-provider-boundary/dev pipeline/deploy-prod"), ua)
+
+	httpClient := &http.Client{Transport: newHeaderTransport(nil, http.Header{"User-Agent": []string{ua}})}
+	resp, err := httpClient.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, ua, gotUserAgent)
+}
+
+func TestCustomHeaders(t *testing.T) {
+	var gotHeaders http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"headers": map[string]interface{}{
+			"x-tenant-id": "team-a",
+			"X-Api-Key":   "s3cr3t",
+		},
+	})
+	headers, err := customHeaders(d)
+	require.NoError(t, err)
+
+	httpClient := &http.Client{Transport: newHeaderTransport(nil, headers)}
+	resp, err := httpClient.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "team-a", gotHeaders.Get("X-Tenant-Id"))
+	assert.Equal(t, "s3cr3t", gotHeaders.Get("X-Api-Key"))
+
+	d = schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"headers": map[string]interface{}{
+			"authorization": "Bearer foo",
+		},
+	})
+	_, err = customHeaders(d)
+	assert.Error(t, err)
+}
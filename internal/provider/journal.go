@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+// journal appends a JSON line for every create, update and delete the
+// provider performs to a local file, giving audit and compliance teams a
+// record of the changes that doesn't depend on the controller's logs.
+type journal struct {
+	mu   sync.Mutex
+	path string
+}
+
+// journalEntry is a single line of the journal.
+type journalEntry struct {
+	Time         time.Time `json:"time"`
+	Operation    string    `json:"operation"`
+	ResourceType string    `json:"resource_type"`
+	Id           string    `json:"id,omitempty"`
+	ScopeId      string    `json:"scope_id,omitempty"`
+	Actor        string    `json:"actor,omitempty"`
+	Controller   string    `json:"controller"`
+	Status       string    `json:"status"`
+	Error        string    `json:"error,omitempty"`
+}
+
+func newJournal(path string) (*journal, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	// Make sure the file can be written before anything gets changed
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &journal{path: path}, nil
+}
+
+func (j *journal) record(entry journalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	f, err := os.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// journalResources wraps the create, update and delete functions of every
+// resource so they are recorded in the journal when one is configured.
+func journalResources(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		_, hasScope := r.Schema[ScopeIdKey]
+		if r.CreateContext != nil {
+			r.CreateContext = journaled(name, "create", hasScope, r.CreateContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = journaled(name, "update", hasScope, r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = journaled(name, "delete", hasScope, r.DeleteContext)
+		}
+	}
+}
+
+type resourceOperationFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func journaled(resourceType, operation string, hasScope bool, f resourceOperationFunc) resourceOperationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		md, ok := meta.(*metaData)
+		if !ok || md == nil || md.journal == nil {
+			return f(ctx, d, meta)
+		}
+
+		// The ID is gone once a delete succeeded
+		id := d.Id()
+		diags := f(ctx, d, meta)
+		if d.Id() != "" {
+			id = d.Id()
+		}
+
+		entry := journalEntry{
+			Time:         time.Now().UTC(),
+			Operation:    operation,
+			ResourceType: resourceType,
+			Id:           id,
+			Actor:        md.actor(),
+			Controller:   md.client.Addr(),
+			Status:       "succeeded",
+		}
+		if hasScope {
+			entry.ScopeId = d.Get(ScopeIdKey).(string)
+		}
+		if diags.HasError() {
+			entry.Status = "failed"
+			var errs []string
+			for _, dg := range diags {
+				if dg.Severity == diag.Error {
+					errs = append(errs, dg.Summary)
+				}
+			}
+			entry.Error = strings.Join(errs, "; ")
+		}
+
+		if err := md.journal.record(entry); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Error writing to the change journal",
+				Detail:   err.Error(),
+			})
+		}
+		return diags
+	}
+}
+
+// actor describes who the provider acts as, for the journal.
+func (md *metaData) actor() string {
+	if md.recoveryKmsWrapper != nil {
+		return "recovery-kms"
+	}
+
+	md.tokenLock.Lock()
+	userId := md.userId
+	md.tokenLock.Unlock()
+	if userId != "" {
+		return userId
+	}
+
+	// Tokens are made of the ID of the auth token and its secret part
+	parts := strings.SplitN(md.client.Token(), "_", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[0] + "_" + parts[1]
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	j, err := newJournal(path)
+	require.NoError(t, err)
+
+	client, err := api.NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr("https://boundary.example.com"))
+	client.SetToken("at_1234567890_secretpart")
+	md := &metaData{client: client, journal: j}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			ScopeIdKey: {Type: schema.TypeString, Required: true},
+		},
+		CreateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			d.SetId("g_1234567890")
+			return nil
+		},
+		DeleteContext: func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			return diag.Errorf("error deleting group")
+		},
+	}
+	journalResources(map[string]*schema.Resource{"boundary_group": r})
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{ScopeIdKey: "o_1234567890"})
+	assert.False(t, r.CreateContext(context.Background(), d, md).HasError())
+	assert.True(t, r.DeleteContext(context.Background(), d, md).HasError())
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	require.Len(t, lines, 2)
+
+	var entries []journalEntry
+	for _, line := range lines {
+		var entry journalEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		assert.False(t, entry.Time.IsZero())
+		entry.Time = time.Time{}
+		entries = append(entries, entry)
+	}
+	assert.Equal(t, journalEntry{
+		Operation:    "create",
+		ResourceType: "boundary_group",
+		Id:           "g_1234567890",
+		ScopeId:      "o_1234567890",
+		Actor:        "at_1234567890",
+		Controller:   "https://boundary.example.com",
+		Status:       "succeeded",
+	}, entries[0])
+	assert.Equal(t, "delete", entries[1].Operation)
+	assert.Equal(t, "failed", entries[1].Status)
+	assert.Equal(t, "error deleting group", entries[1].Error)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/hashicorp/boundary/api/authtokens"
+	nkeyring "github.com/jefferai/keyring"
+	zkeyring "github.com/zalando/go-keyring"
+)
+
+const (
+	// keyringStoredTokenName is the service name the Boundary CLI uses when
+	// storing auth tokens in the system keyring.
+	keyringStoredTokenName = "HashiCorp Boundary Auth Token"
+
+	keyringTypeAuto          = "auto"
+	keyringTypeWincred       = "wincred"
+	keyringTypeKeychain      = "keychain"
+	keyringTypePass          = "pass"
+	keyringTypeSecretService = "secret-service"
+
+	defaultKeyringTokenName = "default"
+)
+
+var validKeyringTypes = []string{
+	keyringTypeAuto,
+	keyringTypeWincred,
+	keyringTypeKeychain,
+	keyringTypePass,
+	keyringTypeSecretService,
+}
+
+// discoverKeyringType resolves the "auto" keyring type the same way the
+// Boundary CLI does, so the provider looks in the place the CLI wrote to.
+func discoverKeyringType(keyringType string) (string, error) {
+	return resolveKeyringType(keyringType, runtime.GOOS, nkeyring.AvailableBackends)
+}
+
+// resolveKeyringType does the work of discoverKeyringType for the given OS and
+// available backends.
+func resolveKeyringType(keyringType, goos string, availableBackends func() []nkeyring.BackendType) (string, error) {
+	if keyringType != keyringTypeAuto {
+		return keyringType, nil
+	}
+
+	switch goos {
+	case "windows":
+		return keyringTypeWincred, nil
+	case "darwin":
+		return keyringTypeKeychain, nil
+	}
+
+	avail := availableBackends()
+	for _, v := range avail {
+		if v == nkeyring.PassBackend {
+			return keyringTypePass, nil
+		}
+	}
+	for _, v := range avail {
+		if v == nkeyring.SecretServiceBackend {
+			return keyringTypeSecretService, nil
+		}
+	}
+
+	return "", errors.New("no keyring type could be discovered")
+}
+
+// readTokenFromKeyring returns the auth token stored by "boundary authenticate"
+// under the given token name.
+func readTokenFromKeyring(keyringType, tokenName string) (string, error) {
+	keyringType, err := discoverKeyringType(keyringType)
+	if err != nil {
+		return "", err
+	}
+
+	var token string
+	switch keyringType {
+	case keyringTypeWincred, keyringTypeKeychain:
+		token, err = zkeyring.Get(keyringStoredTokenName, tokenName)
+		if err != nil {
+			if err == zkeyring.ErrNotFound {
+				return "", fmt.Errorf("no token named %q found in the %s keyring", tokenName, keyringType)
+			}
+			return "", fmt.Errorf("error reading token from the %s keyring: %w", keyringType, err)
+		}
+
+	default:
+		kr, err := nkeyring.Open(nkeyring.Config{
+			LibSecretCollectionName: "login",
+			PassPrefix:              "HashiCorp_Boundary",
+			AllowedBackends:         []nkeyring.BackendType{nkeyring.BackendType(keyringType)},
+		})
+		if err != nil {
+			return "", fmt.Errorf("error opening the %s keyring: %w", keyringType, err)
+		}
+		item, err := kr.Get(tokenName)
+		if err != nil {
+			if err == nkeyring.ErrKeyNotFound {
+				return "", fmt.Errorf("no token named %q found in the %s keyring", tokenName, keyringType)
+			}
+			return "", fmt.Errorf("error reading token from the %s keyring: %w", keyringType, err)
+		}
+		token = string(item.Data)
+	}
+
+	if token == "" {
+		return "", fmt.Errorf("empty token named %q found in the %s keyring", tokenName, keyringType)
+	}
+
+	tokenBytes, err := base64.RawStdEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("error base64-decoding token read from the %s keyring: %w", keyringType, err)
+	}
+	var authToken authtokens.AuthToken
+	if err := json.Unmarshal(tokenBytes, &authToken); err != nil {
+		return "", fmt.Errorf("error unmarshaling token read from the %s keyring: %w", keyringType, err)
+	}
+	if authToken.Token == "" {
+		return "", fmt.Errorf("token named %q in the %s keyring is missing its token value", tokenName, keyringType)
+	}
+	if !authToken.ExpirationTime.IsZero() && authToken.ExpirationTime.Before(time.Now()) {
+		return "", fmt.Errorf("token named %q in the %s keyring expired at %s, please run \"boundary authenticate\" again", tokenName, keyringType, authToken.ExpirationTime.Format(time.RFC3339))
+	}
+
+	return authToken.Token, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nkeyring "github.com/jefferai/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	zkeyring "github.com/zalando/go-keyring"
+)
+
+func TestResolveKeyringType(t *testing.T) {
+	backends := func(b ...nkeyring.BackendType) func() []nkeyring.BackendType {
+		return func() []nkeyring.BackendType { return b }
+	}
+
+	tests := []struct {
+		name        string
+		keyringType string
+		goos        string
+		backends    func() []nkeyring.BackendType
+		want        string
+		wantErr     string
+	}{
+		{name: "explicit", keyringType: keyringTypePass, goos: "darwin", backends: backends(), want: keyringTypePass},
+		{name: "windows", keyringType: keyringTypeAuto, goos: "windows", backends: backends(), want: keyringTypeWincred},
+		{name: "darwin", keyringType: keyringTypeAuto, goos: "darwin", backends: backends(), want: keyringTypeKeychain},
+		{name: "linux-pass-first", keyringType: keyringTypeAuto, goos: "linux", backends: backends(nkeyring.SecretServiceBackend, nkeyring.PassBackend), want: keyringTypePass},
+		{name: "linux-secret-service", keyringType: keyringTypeAuto, goos: "linux", backends: backends(nkeyring.SecretServiceBackend), want: keyringTypeSecretService},
+		{name: "linux-none", keyringType: keyringTypeAuto, goos: "linux", backends: backends(nkeyring.FileBackend), wantErr: "no keyring type could be discovered"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveKeyringType(tt.keyringType, tt.goos, tt.backends)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// storeKeyringToken stores an auth token in the mocked keyring the way
+// "boundary authenticate" does.
+func storeKeyringToken(t *testing.T, tokenName string, at authtokens.AuthToken) {
+	t.Helper()
+	b, err := json.Marshal(at)
+	require.NoError(t, err)
+	require.NoError(t, zkeyring.Set(keyringStoredTokenName, tokenName, base64.RawStdEncoding.EncodeToString(b)))
+}
+
+func TestReadTokenFromKeyring(t *testing.T) {
+	zkeyring.MockInit()
+	storeKeyringToken(t, "valid", authtokens.AuthToken{Token: "at_valid", ExpirationTime: time.Now().Add(time.Hour)})
+	storeKeyringToken(t, "expired", authtokens.AuthToken{Token: "at_expired", ExpirationTime: time.Now().Add(-time.Hour)})
+	storeKeyringToken(t, "empty", authtokens.AuthToken{})
+
+	tests := []struct {
+		tokenName string
+		want      string
+		wantErr   string
+	}{
+		{tokenName: "valid", want: "at_valid"},
+		{tokenName: "missing", wantErr: `no token named "missing" found in the keychain keyring`},
+		{tokenName: "expired", wantErr: `token named "expired" in the keychain keyring expired at`},
+		{tokenName: "empty", wantErr: `token named "empty" in the keychain keyring is missing its token value`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tokenName, func(t *testing.T) {
+			got, err := readTokenFromKeyring(keyringTypeKeychain, tt.tokenName)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProviderAuthenticateCliToken(t *testing.T) {
+	zkeyring.MockInit()
+	for _, env := range []string{"BOUNDARY_TOKEN", "BOUNDARY_TOKEN_NAME", "BOUNDARY_RECOVERY_CONFIG"} {
+		t.Setenv(env, "")
+	}
+	storeKeyringToken(t, defaultKeyringTokenName, authtokens.AuthToken{Token: "at_default"})
+	storeKeyringToken(t, "other", authtokens.AuthToken{Token: "at_other"})
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		want    string
+		wantErr string
+	}{
+		{
+			name: "default token name",
+			raw:  map[string]interface{}{"use_cli_token": true, "keyring_type": keyringTypeKeychain},
+			want: "at_default",
+		},
+		{
+			name: "token name",
+			raw:  map[string]interface{}{"use_cli_token": true, "keyring_type": keyringTypeKeychain, "token_name": "other"},
+			want: "at_other",
+		},
+		{
+			name: "token set",
+			raw:  map[string]interface{}{"use_cli_token": true, "keyring_type": keyringTypeKeychain, "token": "at_explicit"},
+			want: "at_explicit",
+		},
+		{
+			name:    "missing token",
+			raw:     map[string]interface{}{"use_cli_token": true, "keyring_type": keyringTypeKeychain, "token_name": "missing"},
+			wantErr: `error reading token for "use_cli_token": no token named "missing" found in the keychain keyring`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := api.NewClient(nil)
+			require.NoError(t, err)
+			md := &metaData{client: client}
+
+			d := schema.TestResourceDataRaw(t, New().Schema, tt.raw)
+			err = providerAuthenticate(context.Background(), d, md)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, client.Token())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "sync"
+
+// keyedMutex serializes changes to the same item, keyed by its ID. Resources
+// adding to and removing from a shared item, e.g. grants of a role, use
+// automatic versioning, and Terraform applying several of them in parallel
+// would otherwise make them fail with version mismatches. Changes to other
+// items are not held up.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*sync.Mutex)}
+}
+
+// lock locks the given ID and returns the function unlocking it, so it can be
+// deferred right away.
+func (m *keyedMutex) lock(id string) func() {
+	if m == nil {
+		return func() {}
+	}
+	m.mu.Lock()
+	l, ok := m.locks[id]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[id] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
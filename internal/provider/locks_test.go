@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	m := newKeyedMutex()
+
+	// Changes to the same item never overlap
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer m.lock("r_1234567890")()
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, maxRunning)
+
+	// Other items are not held up
+	unlock := m.lock("r_1234567890")
+	m.lock("r_0987654321")()
+	unlock()
+
+	// A nil mutex doesn't lock anything
+	var none *keyedMutex
+	none.lock("r_1234567890")()
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	redacted = "[REDACTED]"
+
+	// maxLoggedBodySize bounds how much of a body is logged, larger bodies
+	// are cut off.
+	maxLoggedBodySize = 64 * 1024
+)
+
+// sensitiveKeySuffixes lists the suffixes of JSON keys whose values are never
+// logged. Matching on suffixes catches fields like "login_password",
+// "client_secret", "secret_access_key" or
+// "controller_generated_activation_token".
+var sensitiveKeySuffixes = []string{
+	"password",
+	"passphrase",
+	"_key",
+	"secret",
+	"secrets",
+	"token",
+	"hmac",
+}
+
+// sensitiveKeys lists JSON keys that hold secrets but don't share a suffix
+// with any of the above, e.g. the object of a JSON credential.
+var sensitiveKeys = []string{
+	"key",
+	"object",
+}
+
+// sensitiveHeaders lists the headers whose values are never logged.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Vault-Token",
+}
+
+// loggingTransport logs every request sent to the controller and the response
+// to it through tflog, with secrets redacted. It sits below the provider
+// transport so retried requests and logins are logged too.
+type loggingTransport struct {
+	wrapped http.RoundTripper
+}
+
+func newLoggingTransport(wrapped http.RoundTripper) *loggingTransport {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &loggingTransport{wrapped: wrapped}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = withBody(req, reqBody)
+	}
+	tflog.Debug(ctx, "Sending Boundary API request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    redactBody(reqBody),
+	})
+
+	resp, err := t.wrapped.RoundTrip(req)
+	if err != nil {
+		tflog.Debug(ctx, "Boundary API request failed", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"error":  err.Error(),
+		})
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	tflog.Debug(ctx, "Received Boundary API response", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"status":  resp.StatusCode,
+		"headers": redactHeaders(resp.Header),
+		"body":    redactBody(respBody),
+	})
+	return resp, nil
+}
+
+// redactHeaders returns a copy of headers that is safe to log.
+func redactHeaders(headers http.Header) map[string]string {
+	out := make(map[string]string, len(headers))
+	for k, v := range headers {
+		out[k] = strings.Join(v, ", ")
+	}
+	for _, h := range sensitiveHeaders {
+		if _, ok := out[http.CanonicalHeaderKey(h)]; ok {
+			out[http.CanonicalHeaderKey(h)] = redacted
+		}
+	}
+	return out
+}
+
+// redactBody returns a version of the JSON body that is safe to log. Bodies
+// that are not JSON are not logged at all since they can't be redacted.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "[non-JSON body omitted]"
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "[body omitted]"
+	}
+	if len(out) > maxLoggedBodySize {
+		return string(out[:maxLoggedBodySize]) + "...[truncated]"
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isSensitiveKey(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+		return v
+	case string:
+		// Catch PEM-encoded keys regardless of the key they are stored under
+		if strings.Contains(v, "PRIVATE KEY-----") {
+			return redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, key := range sensitiveKeys {
+		if k == key {
+			return true
+		}
+	}
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(k, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// NewMuxServerFactory returns a protocol v6 server combining the SDKv2
+// provider, upgraded from protocol v5, with the framework provider. New
+// resources can be written against the framework while the existing ones keep
+// being served by the SDK.
+func NewMuxServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	sdkProvider := New()
+
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDKv2 provider has to come first so that it is configured before
+	// the framework provider, which reuses its configuration.
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+		providerserver.NewProtocol6(NewFrameworkProvider(sdkProvider)),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
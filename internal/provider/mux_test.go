@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuxServerProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := NewMuxServerFactory(ctx)
+	require.NoError(t, err)
+
+	// The mux server rejects differing provider schemas, so this fails if
+	// the framework provider gets out of sync with the SDKv2 one
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	assert.Contains(t, resp.ResourceSchemas, "boundary_scope")
+}
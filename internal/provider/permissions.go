@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// permissionCheck describes what a resource needs to be allowed to do on the
+// controller, so the grants of the authenticated principal can be checked
+// while planning.
+type permissionCheck struct {
+	// collection is the name of the API collection of the resource, e.g.
+	// "targets" or "host-catalogs"
+	collection string
+
+	// parentKey is the attribute holding the ID of the item the resource is
+	// created in, parentCollection the API collection of that item
+	parentKey        string
+	parentCollection string
+
+	// createAction, if set, returns the action needed to create the resource
+	// when it isn't simply "create"
+	createAction func(d *schema.ResourceDiff) string
+
+	// parentAction, if set, is the action on the parent item itself that
+	// creating and destroying the resource needs, e.g. "add-grants" for a
+	// grant added to a role. Such resources have no item of their own, so
+	// collection and createAction are not used.
+	parentAction string
+}
+
+// checkPermissions returns a CustomizeDiffFunc that, when the provider's
+// preflight_permission_check is enabled, fails the plan if the principal is
+// not allowed to create the resource in its parent, to add it to its parent
+// or to update it.
+//
+// Boundary reports what the principal may do through the authorized_actions
+// of an item and the authorized_collection_actions of its parent, so the
+// check only costs a read of either. Destroys are not checked since the SDK
+// doesn't customize their diffs.
+func checkPermissions(pc permissionCheck) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		md, ok := meta.(*metaData)
+		if !ok || md == nil || !md.preflightPermissionCheck {
+			return nil
+		}
+
+		if d.Id() != "" && len(d.GetChangedKeysPrefix("")) == 0 {
+			return nil
+		}
+
+		// Resources with a parent action are replaced rather than updated,
+		// which needs the same action as creating them
+		if d.Id() == "" || pc.parentAction != "" {
+			if !d.NewValueKnown(pc.parentKey) {
+				// The parent is created in the same run
+				return nil
+			}
+			parentId := d.Get(pc.parentKey).(string)
+			if parentId == "" {
+				return nil
+			}
+			parent, err := md.readParent(ctx, pc.parentCollection, parentId)
+			if err != nil {
+				if isNotFound(err) {
+					return nil
+				}
+				return fmt.Errorf("error checking permissions on %q: %w", parentId, err)
+			}
+			if pc.parentAction != "" {
+				if !hasAuthorizedAction(parent, pc.parentAction) {
+					return fmt.Errorf("the authenticated principal is not allowed to %s on %q", pc.parentAction, parentId)
+				}
+				return nil
+			}
+			action := "create"
+			if pc.createAction != nil {
+				action = pc.createAction(d)
+			}
+			if !hasAuthorizedCollectionAction(parent, pc.collection, action) {
+				return fmt.Errorf("the authenticated principal is not allowed to %s %s in %q", action, pc.collection, parentId)
+			}
+			return nil
+		}
+
+		item, err := md.readItem(ctx, pc.collection, d.Id())
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error checking permissions on %q: %w", d.Id(), err)
+		}
+		if !hasAuthorizedAction(item, "update") {
+			return fmt.Errorf("the authenticated principal is not allowed to update %q", d.Id())
+		}
+		return nil
+	}
+}
+
+// readParent reads the item resources get created in, going through the read
+// cache for scopes and auth methods.
+func (md *metaData) readParent(ctx context.Context, collection, id string) (map[string]interface{}, error) {
+	switch collection {
+	case "scopes":
+		return md.readScope(ctx, id)
+	case "auth-methods":
+		return md.readAuthMethod(ctx, id)
+	default:
+		return md.readItem(ctx, collection, id)
+	}
+}
+
+// readItem reads any item from the controller as a response map.
+func (md *metaData) readItem(ctx context.Context, collection, id string) (map[string]interface{}, error) {
+	req, err := md.client.NewRequest(ctx, http.MethodGet, path.Join(collection, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := md.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	var item map[string]interface{}
+	apiErr, err := resp.Decode(&item)
+	if err != nil {
+		return nil, err
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return item, nil
+}
+
+func isNotFound(err error) bool {
+	apiErr := api.AsServerError(err)
+	return apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound
+}
+
+func hasAuthorizedAction(item map[string]interface{}, action string) bool {
+	actions, _ := item["authorized_actions"].([]interface{})
+	return containsString(actions, action)
+}
+
+func hasAuthorizedCollectionAction(item map[string]interface{}, collection, action string) bool {
+	collections, _ := item["authorized_collection_actions"].(map[string]interface{})
+	actions, _ := collections[collection].([]interface{})
+	return containsString(actions, action)
+}
+
+func containsString(values []interface{}, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var actions []string
+		switch strings.TrimPrefix(r.URL.Path, "/v1/scopes/") {
+		case "p_allowed":
+			actions = []string{"create", "list"}
+		case "p_denied":
+			actions = []string{"list"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":                            strings.TrimPrefix(r.URL.Path, "/v1/scopes/"),
+			"authorized_collection_actions": map[string]interface{}{"groups": actions},
+		})
+	}))
+	defer srv.Close()
+
+	config, err := api.DefaultConfig()
+	require.NoError(t, err)
+	config.Addr = srv.URL
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		scopeId string
+		enabled bool
+		wantErr bool
+	}{
+		{name: "disabled", scopeId: "p_denied"},
+		{name: "allowed", scopeId: "p_allowed", enabled: true},
+		{name: "denied", scopeId: "p_denied", enabled: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := &metaData{client: client, cache: newReadCache(), preflightPermissionCheck: tt.enabled}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				ScopeIdKey: tt.scopeId,
+				NameKey:    "foo",
+			})
+			_, err := resourceGroup().Diff(context.Background(), nil, config, md)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, `the authenticated principal is not allowed to create groups in "p_denied"`)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckPermissionsParentAction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var actions []string
+		switch strings.TrimPrefix(r.URL.Path, "/v1/roles/") {
+		case "r_allowed":
+			actions = []string{"read", "add-grants"}
+		case "r_denied":
+			actions = []string{"read"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":                 strings.TrimPrefix(r.URL.Path, "/v1/roles/"),
+			"authorized_actions": actions,
+		})
+	}))
+	defer srv.Close()
+
+	config, err := api.DefaultConfig()
+	require.NoError(t, err)
+	config.Addr = srv.URL
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		roleId  string
+		wantErr bool
+	}{
+		{name: "allowed", roleId: "r_allowed"},
+		{name: "denied", roleId: "r_denied", wantErr: true},
+		{name: "missing role", roleId: "r_missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := &metaData{client: client, cache: newReadCache(), preflightPermissionCheck: true}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				roleIdKey:          tt.roleId,
+				roleGrantStringKey: "ids=*;type=*;actions=read",
+			})
+			_, err := resourceRoleGrant().Diff(context.Background(), nil, config, md)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, `the authenticated principal is not allowed to add-grants on "r_denied"`)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+// parsePluginJson reads the JSON object of the attributes_json or secrets_json
+// of a plugin-backed resource, which may also be a file:// or env:// path.
+func parsePluginJson(value, what string) (string, map[string]interface{}, error) {
+	str, err := parseutil.ParsePath(value)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		return "", nil, fmt.Errorf("error parsing path with %s: %w", what, err)
+	}
+	switch str {
+	case "null", "":
+		return str, nil, nil
+	}
+	// What comes in is json-encoded but we want to set a
+	// map[string]interface{} so we unmarshal it and set that
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(str), &m); err != nil {
+		return "", nil, fmt.Errorf("error unmarshaling %s: %w", what, err)
+	}
+	return str, m, nil
+}
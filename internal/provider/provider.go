@@ -8,17 +8,30 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/sdk/wrapper"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	kms_plugin_assets "github.com/hashicorp/terraform-provider-boundary/plugins/kms"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	defaultRequestsPerSecond = 5.0
+	defaultRequestsBurst     = 5
 )
 
 func init() {
@@ -31,76 +44,294 @@ func New() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"addr": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_ADDR", nil),
 				Description: `The base url of the Boundary API, e.g. "http://127.0.0.1:9200". If not set, it will be read from the "BOUNDARY_ADDR" env var.`,
 			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BOUNDARY_CLUSTER_ID", nil),
+				ValidateFunc: validation.IsUUID,
+				Description:  `The ID of an HCP Boundary cluster, used instead of "addr" to connect to the cluster's API at https://<cluster_id>.boundary.hashicorp.cloud. Can also be set with the BOUNDARY_CLUSTER_ID environment variable.`,
+			},
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: `The Boundary token to use, as a string or path on disk containing just the string. If set, the token read here will be used in place of authenticating with the auth method specified in "auth_method_id", although the recovery KMS mechanism will still override this. Can also be set with the BOUNDARY_TOKEN environment variable.`,
 			},
+			"token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"token"},
+				Description:   `A path on disk to a file containing just the Boundary token to use. The file is read when the provider is configured and read again whenever the controller rejects the current token, so short-lived tokens that are rotated on disk are picked up during long-running operations. The recovery KMS mechanism will still override this.`,
+			},
+			"use_cli_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `When set to true, the provider will use the auth token stored in the system keyring by the Boundary CLI (e.g. after running "boundary authenticate") if no "token" is set. The recovery KMS mechanism will still override this.`,
+			},
+			"keyring_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BOUNDARY_KEYRING_TYPE", keyringTypeAuto),
+				ValidateFunc: validation.StringInSlice(validKeyringTypes, false),
+				Description:  `The type of keyring the Boundary CLI stored its auth token in when "use_cli_token" is set. Can be one of "auto", "wincred", "keychain", "pass" or "secret-service". Defaults to "auto", which matches the CLI's own discovery logic. Can also be set with the BOUNDARY_KEYRING_TYPE environment variable.`,
+			},
+			"token_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_TOKEN_NAME", defaultKeyringTokenName),
+				Description: `The name the Boundary CLI stored its auth token under when "use_cli_token" is set. Defaults to "default". Can also be set with the BOUNDARY_TOKEN_NAME environment variable.`,
+			},
 			"recovery_kms_hcl": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Can be a heredoc string or a path on disk. If set, the string/file will be parsed as HCL and used with the recovery KMS mechanism. While this is set, it will override any other authentication information; the KMS mechanism will always be used. See Boundary's KMS docs for examples: https://boundaryproject.io/docs/configuration/kms",
+				Description: "Can be a heredoc string or a path on disk. If set, the string/file will be parsed as HCL and used with the recovery KMS mechanism. While this is set, it will override any other authentication information; the KMS mechanism will always be used. The aead, awskms, azurekeyvault, gcpckms and transit KMS types are supported. See Boundary's KMS docs for examples: https://boundaryproject.io/docs/configuration/kms",
+			},
+			"recovery_kms_hcl_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_RECOVERY_CONFIG", nil),
+				Description: `A path on disk to a file containing the HCL of the "kms" block to use with the recovery KMS mechanism. The file is read and parsed when the provider is configured. Behaves like "recovery_kms_hcl" but avoids having to inline the HCL in the Terraform configuration; if both are set "recovery_kms_hcl" takes precedence. Can also be set with the BOUNDARY_RECOVERY_CONFIG environment variable.`,
 			},
 			"auth_method_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The auth method ID e.g. ampw_1234567890",
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_AUTH_METHOD_ID", nil),
+				Description: "The auth method ID e.g. ampw_1234567890. The provider logs in to the auth method on its first API call rather than when it is configured, so validation and plans that need no API access work without reaching the controller. The token obtained from the auth method is replaced automatically shortly before it expires or when the controller rejects it, so long-running applies outlive the token's time to live. Can also be set with the BOUNDARY_AUTH_METHOD_ID environment variable.",
 			},
 			"password_auth_method_login_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The auth method login name for password-style auth methods",
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME", nil),
+				Description: `The auth method login name for password-style auth methods. Can also be set with the BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME environment variable.`,
 			},
 			"password_auth_method_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The auth method password for password-style auth methods",
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD", nil),
+				Description: `The auth method password for password-style auth methods. Can also be set with the BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD environment variable.`,
+			},
+			"ldap_auth_method_login_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_AUTHENTICATE_LDAP_LOGIN_NAME", nil),
+				Description: `The auth method login name for ldap-style auth methods. Can also be set with the BOUNDARY_AUTHENTICATE_LDAP_LOGIN_NAME environment variable.`,
+			},
+			"ldap_auth_method_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_AUTHENTICATE_LDAP_PASSWORD", nil),
+				Description: `The auth method password for ldap-style auth methods. Can also be set with the BOUNDARY_AUTHENTICATE_LDAP_PASSWORD environment variable.`,
 			},
 			"tls_insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "When set to true, does not validate the Boundary API endpoint certificate",
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_TLS_INSECURE", nil),
+				Description: "When set to true, does not validate the Boundary API endpoint certificate. Can also be set with the BOUNDARY_TLS_INSECURE environment variable.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BOUNDARY_MAX_RETRIES", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  `The maximum number of times an API call is retried after a transient error, such as a connection failure, a 429 or a 5xx response. Set to 0 to turn retries off. Defaults to the value of the BOUNDARY_MAX_RETRIES environment variable, or 2 if that is not set.`,
+			},
+			"retry_min_wait": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description:  `The minimum time to wait before retrying a failed API call, as a duration string such as "500ms" or "2s". Defaults to 1s.`,
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description:  `The maximum time to wait before retrying a failed API call, as a duration string such as "5s" or "1m". The wait time grows exponentially between "retry_min_wait" and this value. Defaults to 1.5s.`,
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      defaultRequestsPerSecond,
+				ValidateFunc: validation.FloatAtLeast(0.01),
+				Description:  `The maximum number of API calls per second the provider makes to the controller, shared across all resources in a run. Lower it for large workspaces that would otherwise trip the controller's rate limits. Defaults to 5.`,
+			},
+			"requests_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRequestsBurst,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The maximum number of API calls the provider can make in a single burst above "requests_per_second". Defaults to 5.`,
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_CACERT", nil),
+				Description: `A PEM-encoded CA certificate bundle, as a string or path on disk, used to verify the Boundary API endpoint certificate. Use this when the controller is fronted by a private CA that is not in the system trust store. Can also be set with the BOUNDARY_CACERT environment variable.`,
+			},
+			"ca_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_CAPATH", nil),
+				Description: `A path on disk to a directory of PEM-encoded CA certificates used to verify the Boundary API endpoint certificate. Can be combined with "ca_cert". Can also be set with the BOUNDARY_CAPATH environment variable.`,
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BOUNDARY_CLIENT_CERT", nil),
+				RequiredWith: []string{"client_key"},
+				Description:  `A PEM-encoded client certificate, as a string or path on disk, presented to the Boundary API endpoint for mutual TLS. Requires "client_key". Can also be set with the BOUNDARY_CLIENT_CERT environment variable.`,
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BOUNDARY_CLIENT_KEY", nil),
+				Sensitive:    true,
+				RequiredWith: []string{"client_cert"},
+				Description:  `A PEM-encoded private key matching "client_cert", as a string or path on disk. Can also be set with the BOUNDARY_CLIENT_KEY environment variable.`,
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_TLS_SERVER_NAME", nil),
+				Description: `The name to use as the SNI host and to verify the Boundary API endpoint certificate against, when it differs from the host in "addr". Can also be set with the BOUNDARY_TLS_SERVER_NAME environment variable.`,
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The URL of an HTTP, HTTPS or SOCKS5 proxy that all API calls to the controller are sent through, e.g. "http://proxy.example.com:3128". If not set, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honored.`,
+			},
+			"no_proxy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"proxy_url"},
+				Description:  `A list of hosts, domains, IP addresses or CIDR ranges that should be reached directly instead of through "proxy_url", using the same format as the NO_PROXY environment variable.`,
 			},
 			"plugin_execution_dir": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: `Specifies a directory that the Boundary provider can use to write and execute its built-in plugins.`,
 			},
+			"debug_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_PROVIDER_DEBUG_LOGGING", false),
+				Description: `When set to true, every request to the Boundary API and its response are logged at the debug level, which can be seen by setting TF_LOG to DEBUG. Passwords, tokens, private keys and other secrets are redacted. Can also be set with the BOUNDARY_PROVIDER_DEBUG_LOGGING environment variable.`,
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Additional HTTP headers attached to every API call, e.g. tenant or authentication headers required by an API gateway in front of the controller. The "Authorization" header is reserved for the Boundary token and can't be set.`,
+			},
+			"user_agent_extra": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A string appended to the user agent of every API call, e.g. the name of the pipeline or workspace, so the traffic can be attributed in the controller's logs.`,
+			},
+			"controller_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BOUNDARY_CONTROLLER_VERSION", nil),
+				ValidateFunc: validateControllerVersion,
+				Description:  `The version of Boundary the controller runs, e.g. "0.11.2". Resources and attributes that need a newer version fail at plan time with a clear error instead of being rejected by the controller during the apply. The controller API does not report its version, so when this is unset the provider detects it while configuring from the API collections the controller serves, which only tells apart releases up to 0.16. Setting this overrides the detection. Can also be set with the BOUNDARY_CONTROLLER_VERSION environment variable.`,
+			},
+			"default_scope_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The scope ID used by resources that don't set "scope_id". Changing it replaces every resource relying on it. Scopes and workers always need an explicit "scope_id".`,
+			},
+			"journal_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_JOURNAL_FILE", nil),
+				Description: `A path to a local file that a JSON line is appended to for every create, update and delete performed by the provider, recording the time, operation, resource type, ID, scope, actor and outcome. Useful as evidence of changes for audits, independent of the controller's logs. Can also be set with the BOUNDARY_JOURNAL_FILE environment variable.`,
+			},
+			"preflight_permission_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BOUNDARY_PREFLIGHT_PERMISSION_CHECK", false),
+				Description: `Check during the plan that the authenticated principal is allowed to create and update the planned resources, so missing grants are reported before the apply fails midway. This costs a read of the parent of every resource to be created and of every resource to be updated. Destroys are not checked. Can also be set with the BOUNDARY_PREFLIGHT_PERMISSION_CHECK environment variable.`,
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"boundary_accounts":             dataSourceAccounts(),
+			"boundary_aliases":              dataSourceAliases(),
+			"boundary_auth_methods":         dataSourceAuthMethods(),
+			"boundary_credential_libraries": dataSourceCredentialLibraries(),
+			"boundary_credential_stores":    dataSourceCredentialStores(),
+			"boundary_credentials":          dataSourceCredentials(),
+			"boundary_groups":               dataSourceGroups(),
+			"boundary_host_catalogs":        dataSourceHostCatalogs(),
+			"boundary_host_sets":            dataSourceHostSets(),
+			"boundary_hosts":                dataSourceHosts(),
+			"boundary_managed_groups":       dataSourceManagedGroups(),
+			"boundary_policies":             dataSourcePolicies(),
+			"boundary_roles":                dataSourceRoles(),
+			"boundary_scopes":               dataSourceScopes(),
+			"boundary_session_recordings":   dataSourceSessionRecordings(),
+			"boundary_sessions":             dataSourceSessions(),
+			"boundary_storage_buckets":      dataSourceStorageBuckets(),
+			"boundary_target":               dataSourceTarget(),
+			"boundary_targets":              dataSourceTargets(),
+			"boundary_users":                dataSourceUsers(),
+			"boundary_workers":              dataSourceWorkers(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"boundary_account":                      resourceAccount(),
-			"boundary_account_password":             resourceAccountPassword(),
-			"boundary_account_oidc":                 resourceAccountOidc(),
-			"boundary_auth_method":                  resourceAuthMethod(),
-			"boundary_auth_method_password":         resourceAuthMethodPassword(),
-			"boundary_auth_method_oidc":             resourceAuthMethodOidc(),
-			"boundary_credential_library_vault":     resourceCredentialLibraryVault(),
-			"boundary_credential_store_vault":       resourceCredentialStoreVault(),
-			"boundary_credential_store_static":      resourceCredentialStoreStatic(),
-			"boundary_credential_username_password": resourceCredentialUsernamePassword(),
-			"boundary_credential_ssh_private_key":   resourceCredentialSshPrivateKey(),
-			"boundary_credential_json":              resourceCredentialJson(),
-			"boundary_managed_group":                resourceManagedGroup(),
-			"boundary_group":                        resourceGroup(),
-			"boundary_host":                         resourceHost(),
-			"boundary_host_static":                  resourceHostStatic(),
-			"boundary_host_catalog":                 resourceHostCatalog(),
-			"boundary_host_catalog_static":          resourceHostCatalogStatic(),
-			"boundary_host_catalog_plugin":          resourceHostCatalogPlugin(),
-			"boundary_host_set":                     resourceHostSet(),
-			"boundary_host_set_static":              resourceHostSetStatic(),
-			"boundary_host_set_plugin":              resourceHostSetPlugin(),
-			"boundary_role":                         resourceRole(),
-			"boundary_scope":                        resourceScope(),
-			"boundary_target":                       resourceTarget(),
-			"boundary_user":                         resourceUser(),
-			"boundary_worker":                       resourceWorker(),
+			"boundary_account":                                  resourceAccount(),
+			"boundary_account_password":                         resourceAccountPassword(),
+			"boundary_account_oidc":                             resourceAccountOidc(),
+			"boundary_account_ldap":                             resourceAccountLdap(),
+			"boundary_alias_target":                             resourceAliasTarget(),
+			"boundary_auth_method":                              resourceAuthMethod(),
+			"boundary_auth_method_password":                     resourceAuthMethodPassword(),
+			"boundary_auth_method_oidc":                         resourceAuthMethodOidc(),
+			"boundary_auth_method_ldap":                         resourceAuthMethodLdap(),
+			"boundary_credential_library_vault":                 resourceCredentialLibraryVault(),
+			"boundary_credential_library_vault_ssh_certificate": resourceCredentialLibraryVaultSshCertificate(),
+			"boundary_credential_store_vault":                   resourceCredentialStoreVault(),
+			"boundary_credential_store_static":                  resourceCredentialStoreStatic(),
+			"boundary_credential_username_password":             resourceCredentialUsernamePassword(),
+			"boundary_credential_ssh_private_key":               resourceCredentialSshPrivateKey(),
+			"boundary_credential_json":                          resourceCredentialJson(),
+			"boundary_managed_group":                            resourceManagedGroup(),
+			"boundary_managed_group_ldap":                       resourceManagedGroupLdap(),
+			"boundary_group":                                    resourceGroup(),
+			"boundary_group_membership":                         resourceGroupMembership(),
+			"boundary_host":                                     resourceHost(),
+			"boundary_host_static":                              resourceHostStatic(),
+			"boundary_host_catalog":                             resourceHostCatalog(),
+			"boundary_host_catalog_static":                      resourceHostCatalogStatic(),
+			"boundary_host_catalog_plugin":                      resourceHostCatalogPlugin(),
+			"boundary_host_set":                                 resourceHostSet(),
+			"boundary_host_set_static":                          resourceHostSetStatic(),
+			"boundary_host_set_plugin":                          resourceHostSetPlugin(),
+			"boundary_policy_storage":                           resourcePolicyStorage(),
+			"boundary_role":                                     resourceRole(),
+			"boundary_role_grant":                               resourceRoleGrant(),
+			"boundary_role_principal":                           resourceRolePrincipal(),
+			"boundary_scope":                                    resourceScope(),
+			"boundary_scope_global":                             resourceScopeGlobal(),
+			"boundary_scope_policy_attachment":                  resourceScopePolicyAttachment(),
+			"boundary_scope_primary_auth_method":                resourceScopePrimaryAuthMethod(),
+			"boundary_storage_bucket":                           resourceStorageBucket(),
+			"boundary_target":                                   resourceTarget(),
+			"boundary_target_credential_source":                 resourceTargetCredentialSource(),
+			"boundary_target_host_source":                       resourceTargetHostSource(),
+			"boundary_user":                                     resourceUser(),
+			"boundary_user_account_association":                 resourceUserAccountAssociation(),
+			"boundary_worker":                                   resourceWorker(),
+			"boundary_worker_ca_rotation":                       resourceWorkerCaRotation(),
+			"boundary_worker_tags":                              resourceWorkerTags(),
 		},
 	}
 
+	journalResources(p.ResourcesMap)
 	p.ConfigureContextFunc = providerConfigure(p)
 
 	return p
@@ -109,22 +340,229 @@ func New() *schema.Provider {
 type metaData struct {
 	client             *api.Client
 	recoveryKmsWrapper wrapping.Wrapper
+	defaultScopeId     string
+
+	// controllerVersion is nil when the version of the controller is unknown
+	controllerVersion *goversion.Version
+
+	// controllerVersionBelow, if set, is a release the controller was detected
+	// to be older than
+	controllerVersionBelow *goversion.Version
+
+	// tokenFile, if set, is read again whenever the current token is rejected
+	tokenFile string
+
+	// authMethodId and credentials, if set, are used to authenticate again
+	// when the current token is rejected or about to expire
+	authMethodId string
+	credentials  map[string]interface{}
+	tokenExpiry  time.Time
+
+	// authPending is set until the first login to the auth method has been
+	// attempted, authErr holds the outcome of that attempt
+	authPending bool
+	authErr     error
+
+	// userId is the ID of the user the provider logged in as, if known
+	userId string
+
+	tokenLock sync.Mutex
+
+	// cache holds the scopes and auth methods read during this run
+	cache *readCache
+
+	// locks serializes changes to items shared by several resources
+	locks *keyedMutex
+
+	// preflightPermissionCheck enables checking the grants of the principal
+	// while planning
+	preflightPermissionCheck bool
+
+	// journal, if set, records every change made by the provider
+	journal *journal
+}
+
+// tokenRenewalWindow is how long before its expiration a token obtained from
+// an auth method is replaced by a new one.
+const tokenRenewalWindow = 2 * time.Minute
+
+// canRefreshToken reports whether the provider has a way of obtaining a new
+// token when the current one is rejected.
+func (md *metaData) canRefreshToken() bool {
+	return md.tokenFile != "" || md.authMethodId != ""
+}
+
+// tokenNeedsRenewal reports whether the current token expires soon enough
+// that it should be replaced before sending another request.
+func (md *metaData) tokenNeedsRenewal() bool {
+	md.tokenLock.Lock()
+	defer md.tokenLock.Unlock()
+	return !md.tokenExpiry.IsZero() && time.Until(md.tokenExpiry) < tokenRenewalWindow
+}
+
+// refreshToken obtains a new token to replace staleToken, sets it on the
+// client and returns it.
+func (md *metaData) refreshToken(ctx context.Context, staleToken string) (string, error) {
+	md.tokenLock.Lock()
+	defer md.tokenLock.Unlock()
+
+	// Another request may have already refreshed the token
+	if current := md.client.Token(); current != staleToken {
+		return current, nil
+	}
+
+	if md.tokenFile != "" {
+		token, err := readTokenFile(md.tokenFile)
+		if err != nil {
+			return "", err
+		}
+		md.client.SetToken(token)
+		return token, nil
+	}
+
+	if err := md.authenticate(ctx); err != nil {
+		return "", err
+	}
+	return md.client.Token(), nil
+}
+
+// ensureAuthenticated logs in to the configured auth method the first time it
+// is called, so the controller only has to be reachable once the provider
+// actually makes an API call. The outcome of the login is cached.
+func (md *metaData) ensureAuthenticated(ctx context.Context) error {
+	md.tokenLock.Lock()
+	defer md.tokenLock.Unlock()
+
+	if md.authPending {
+		md.authErr = md.authenticate(ctx)
+		md.authPending = false
+	}
+	return md.authErr
+}
+
+// authenticate logs in to the configured auth method and sets the resulting
+// token on the client. The caller must hold tokenLock unless the metadata is
+// not yet shared.
+func (md *metaData) authenticate(ctx context.Context) error {
+	// Authenticate with a copy of the client that carries no token, the
+	// current one may already have expired
+	client, err := md.client.Clone()
+	if err != nil {
+		return err
+	}
+	client.SetToken("")
+
+	am := authmethods.NewClient(client)
+	at, err := am.Authenticate(ctx, md.authMethodId, "login", md.credentials)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			statusCode := apiErr.Response().StatusCode()
+			if statusCode == http.StatusNotFound {
+				return fmt.Errorf("unknown auth_method_id: %s", err.Error())
+			}
+			if statusCode == http.StatusUnauthorized {
+				return fmt.Errorf("invalid login name or password: %s", err.Error())
+			}
+		}
+		return err
+	}
+
+	token, ok := at.Attributes["token"].(string)
+	if !ok || token == "" {
+		return errors.New("no token returned when authenticating")
+	}
+	md.tokenExpiry = time.Time{}
+	if v, ok := at.Attributes["expiration_time"].(string); ok {
+		if expiry, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			md.tokenExpiry = expiry
+		}
+	}
+	if userId, ok := at.Attributes["user_id"].(string); ok {
+		md.userId = userId
+	}
+	md.client.SetToken(token)
+	return nil
+}
+
+// readTokenFile returns the token stored in the file at path, ignoring any
+// surrounding whitespace.
+func readTokenFile(path string) (string, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return "", err
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(contents))
+	if token == "" {
+		return "", fmt.Errorf("no token found in %q", path)
+	}
+	return token, nil
+}
+
+// hcpClusterAddr returns the API address of the HCP Boundary cluster with the
+// given ID.
+func hcpClusterAddr(clusterId string) string {
+	return fmt.Sprintf("https://%s.boundary.hashicorp.cloud", clusterId)
+}
+
+// readRecoveryKmsHcl returns the recovery KMS HCL from either
+// "recovery_kms_hcl" or "recovery_kms_hcl_file", along with the name of the
+// attribute it was read from.
+func readRecoveryKmsHcl(d *schema.ResourceData) (string, string, error) {
+	if v, ok := d.GetOk("recovery_kms_hcl"); ok {
+		hcl, _, err := ReadPathOrContents(v.(string))
+		if err != nil {
+			return "", "", fmt.Errorf(`error reading data from "recovery_kms_hcl": %v`, err)
+		}
+		return hcl, "recovery_kms_hcl", nil
+	}
+
+	path, err := homedir.Expand(d.Get("recovery_kms_hcl_file").(string))
+	if err != nil {
+		return "", "", fmt.Errorf(`error expanding "recovery_kms_hcl_file": %w`, err)
+	}
+	hcl, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", fmt.Errorf(`error reading "recovery_kms_hcl_file": %w`, err)
+	}
+	return string(hcl), "recovery_kms_hcl_file", nil
 }
 
 func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaData) error {
 	var credentials map[string]interface{}
 
 	authMethodId, authMethodIdOk := d.GetOk("auth_method_id")
-	recoveryKmsHcl, recoveryKmsHclOk := d.GetOk("recovery_kms_hcl")
+	_, recoveryKmsHclOk := d.GetOk("recovery_kms_hcl")
+	if _, ok := d.GetOk("recovery_kms_hcl_file"); ok {
+		recoveryKmsHclOk = true
+	}
 	if token, ok := d.GetOk("token"); ok {
 		md.client.SetToken(token.(string))
 	}
+	if tokenFile, ok := d.GetOk("token_file"); ok && !recoveryKmsHclOk {
+		token, err := readTokenFile(tokenFile.(string))
+		if err != nil {
+			return fmt.Errorf(`error reading token from "token_file": %w`, err)
+		}
+		md.client.SetToken(token)
+		md.tokenFile = tokenFile.(string)
+	}
+	if md.client.Token() == "" && !recoveryKmsHclOk && d.Get("use_cli_token").(bool) {
+		token, err := readTokenFromKeyring(d.Get("keyring_type").(string), d.Get("token_name").(string))
+		if err != nil {
+			return fmt.Errorf(`error reading token for "use_cli_token": %w`, err)
+		}
+		md.client.SetToken(token)
+	}
 
 	switch {
 	case recoveryKmsHclOk:
-		recoveryHclStr, _, err := ReadPathOrContents(recoveryKmsHcl.(string))
+		recoveryHclStr, recoveryHclAttr, err := readRecoveryKmsHcl(d)
 		if err != nil {
-			return fmt.Errorf(`error reading data from "recovery_kms_hcl": %v`, err)
+			return err
 		}
 
 		opts := []pluginutil.Option{
@@ -143,10 +581,10 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 			"recovery",
 			configutil.WithPluginOptions(opts...))
 		if err != nil {
-			return fmt.Errorf(`error reading wrappers from "recovery_kms_hcl": %v`, err)
+			return fmt.Errorf(`error reading wrappers from %q: %v`, recoveryHclAttr, err)
 		}
 		if wrapper == nil {
-			return errors.New(`No "kms" block with purpose "recovery" found in "recovery_kms_hcl"`)
+			return fmt.Errorf(`No "kms" block with purpose "recovery" found in %q`, recoveryHclAttr)
 		}
 
 		md.recoveryKmsWrapper = wrapper
@@ -154,7 +592,8 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 		return nil
 
 	case md.client.Token() != "":
-		// Use the token sourced from the conf file or env var
+		// Use the token sourced from the conf file, token file, env var or CLI
+		// keyring
 
 	case authMethodIdOk:
 		switch {
@@ -173,26 +612,31 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 				"password":   authMethodPassword,
 			}
 
+		case strings.HasPrefix(authMethodId.(string), "amldap"):
+			// LDAP-style
+			authMethodLoginName, ok := d.GetOk("ldap_auth_method_login_name")
+			if !ok {
+				return errors.New("ldap-style auth method login name not set, please set ldap_auth_method_login_name on the provider")
+			}
+			authMethodPassword, ok := d.GetOk("ldap_auth_method_password")
+			if !ok {
+				return errors.New("ldap-style auth method password not set, please set ldap_auth_method_password on the provider")
+			}
+			credentials = map[string]interface{}{
+				"login_name": authMethodLoginName,
+				"password":   authMethodPassword,
+			}
+
 		default:
 			return errors.New("no suitable typed auth method information found")
 		}
 
-		am := authmethods.NewClient(md.client)
-
-		at, err := am.Authenticate(ctx, authMethodId.(string), "login", credentials)
-		if err != nil {
-			if apiErr := api.AsServerError(err); apiErr != nil {
-				statusCode := apiErr.Response().StatusCode()
-				if statusCode == http.StatusNotFound {
-					return fmt.Errorf("unknown auth_method_id: %s", err.Error())
-				}
-				if statusCode == http.StatusUnauthorized {
-					return fmt.Errorf("invalid login name or password: %s", err.Error())
-				}
-			}
-			return err
-		}
-		md.client.SetToken(at.Attributes["token"].(string))
+		// The login itself is deferred until the first API call. The auth
+		// method and credentials are kept so a new token can be obtained once
+		// the first one expires.
+		md.authMethodId = authMethodId.(string)
+		md.credentials = credentials
+		md.authPending = true
 
 	default:
 		return errors.New("no suitable auth method information found")
@@ -201,38 +645,166 @@ func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaD
 	return nil
 }
 
+// maxRetries returns the configured "max_retries", if set. GetOk can't be
+// used since it treats 0, which turns retries off, as unset.
+func maxRetries(d *schema.ResourceData) (int, bool) {
+	v, ok := d.GetOkExists("max_retries") //nolint:staticcheck
+	if !ok {
+		return 0, false
+	}
+	return v.(int), true
+}
+
+// retryBackoff returns a backoff function honoring the configured retry wait
+// bounds, or nil if neither is set.
+func retryBackoff(d *schema.ResourceData) (retryablehttp.Backoff, error) {
+	var minWait, maxWait time.Duration
+	if v, ok := d.GetOk("retry_min_wait"); ok {
+		minWait, _ = time.ParseDuration(v.(string))
+	}
+	if v, ok := d.GetOk("retry_max_wait"); ok {
+		maxWait, _ = time.ParseDuration(v.(string))
+	}
+	if minWait == 0 && maxWait == 0 {
+		return nil, nil
+	}
+	if minWait != 0 && maxWait != 0 && maxWait < minWait {
+		return nil, errors.New(`"retry_max_wait" must not be less than "retry_min_wait"`)
+	}
+
+	return func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		if minWait != 0 {
+			min = minWait
+		}
+		if maxWait != 0 {
+			max = maxWait
+		}
+		if max < min {
+			max = min
+		}
+		return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+	}, nil
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	dur, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q is not a valid duration: %w", k, err)}
+	}
+	if dur < 0 {
+		return nil, []error{fmt.Errorf("%q must not be negative", k)}
+	}
+	return nil, nil
+}
+
 func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		client, err := api.NewClient(nil)
+		config, err := api.DefaultConfig()
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		if url, ok := d.GetOk("addr"); ok {
-			if err := client.SetAddr(url.(string)); err != nil {
-				return nil, diag.FromErr(err)
-			}
+		transport, ok := config.HttpClient.Transport.(*http.Transport)
+		if !ok {
+			return nil, diag.Errorf("unexpected transport type %T on default api client", config.HttpClient.Transport)
 		}
-		if client.Addr() == "" {
-			return nil, diag.Errorf(`"no valid address could be determined from "addr" or "BOUNDARY_ADDR" env var`)
+		if err := configureTLS(d, transport); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if maxRetries, ok := maxRetries(d); ok {
+			config.MaxRetries = maxRetries
+		}
+		backoff, err := retryBackoff(d)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if backoff != nil {
+			config.Backoff = backoff
+		}
+
+		if err := configureProxy(d, transport); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// All TLS settings have to be made on the transport before it gets
+		// wrapped
+		var rt http.RoundTripper = transport
+		if d.Get("debug_logging").(bool) {
+			rt = newLoggingTransport(rt)
+		}
+		headers, err := customHeaders(d)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		headers.Set("User-Agent", userAgent(p, d))
+		rt = newHeaderTransport(rt, headers)
+		pt := newProviderTransport(rt)
+		config.HttpClient.Transport = pt
+
+		client, err := api.NewClient(config)
+		if err != nil {
+			return nil, diag.FromErr(err)
 		}
 
-		if tlsInsecure, ok := d.GetOk("tls_insecure"); ok {
-			if client.SetTLSConfig(&api.TLSConfig{Insecure: tlsInsecure.(bool)}) != nil {
-				return nil, diag.Errorf("could not set insecure tls")
+		addr := d.Get("addr").(string)
+		if clusterId, ok := d.GetOk("cluster_id"); ok {
+			if addr != "" {
+				return nil, diag.Errorf(`only one of "addr" and "cluster_id" can be set, note that "addr" may have been set from the BOUNDARY_ADDR env var`)
+			}
+			addr = hcpClusterAddr(clusterId.(string))
+		}
+		if addr != "" {
+			if err := client.SetAddr(addr); err != nil {
+				return nil, diag.FromErr(err)
 			}
 		}
+		if client.Addr() == "" {
+			return nil, diag.Errorf(`"no valid address could be determined from "addr", "cluster_id" or "BOUNDARY_ADDR" env var`)
+		}
 
-		client.SetLimiter(5, 5)
+		client.SetLimiter(d.Get("requests_per_second").(float64), d.Get("requests_burst").(int))
 
 		md := &metaData{
-			client: client,
+			client:         client,
+			defaultScopeId: d.Get("default_scope_id").(string),
+			cache:          newReadCache(),
+			locks:          newKeyedMutex(),
+
+			preflightPermissionCheck: d.Get("preflight_permission_check").(bool),
+		}
+		if v, ok := d.GetOk("journal_file"); ok {
+			md.journal, err = newJournal(v.(string))
+			if err != nil {
+				return nil, diag.Errorf(`error opening "journal_file": %v`, err)
+			}
+		}
+		if v, ok := d.GetOk("controller_version"); ok {
+			md.controllerVersion, err = goversion.NewVersion(v.(string))
+			if err != nil {
+				return nil, diag.Errorf(`error parsing "controller_version": %v`, err)
+			}
 		}
+		pt.md = md
 
 		if err := providerAuthenticate(ctx, d, md); err != nil {
 			return nil, diag.FromErr(err)
 		}
 
+		if md.controllerVersion == nil {
+			md.controllerVersionBelow, err = md.detectControllerVersion(ctx)
+			if err != nil {
+				// Leave it to the controller to reject unsupported features
+				tflog.Warn(ctx, "Could not detect the version of the controller", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		}
+
 		return md, nil
 	}
 }
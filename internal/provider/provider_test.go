@@ -7,15 +7,24 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/testing/controller"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/hashicorp/go-kms-wrapping/v2/aead"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -134,8 +143,181 @@ func importStep(name string, ignore ...string) resource.TestStep {
 	return step
 }
 
+// testApiMetaData returns provider metadata with a client talking to a fake
+// controller serving the API through h, for unit tests of resources that
+// can't run against the test controller.
+func testApiMetaData(t *testing.T, h http.HandlerFunc) *metaData {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	config, err := api.DefaultConfig()
+	require.NoError(t, err)
+	config.Addr = srv.URL
+	config.Token = ""
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+	return &metaData{client: client, cache: newReadCache(), locks: newKeyedMutex()}
+}
+
+// testApiItem returns a handler checking the method and path of the request,
+// storing its JSON body in body if set, and replying with item.
+func testApiItem(t *testing.T, method, path string, body *map[string]interface{}, item map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.Path != path {
+			t.Errorf("unexpected request %s %s, expected %s %s", r.Method, r.URL.Path, method, path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if body != nil {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(item))
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := New().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+func TestProviderRetryBackoff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"retry_min_wait": "2s",
+		"retry_max_wait": "10s",
+	})
+	backoff, err := retryBackoff(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got := backoff(time.Second, 1500*time.Millisecond, 0, nil); got != 2*time.Second {
+		t.Fatalf("expected first wait of 2s, got %s", got)
+	}
+	if got := backoff(time.Second, 1500*time.Millisecond, 10, nil); got != 10*time.Second {
+		t.Fatalf("expected wait to be capped at 10s, got %s", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"retry_min_wait": "10s",
+		"retry_max_wait": "2s",
+	})
+	if _, err := retryBackoff(d); err == nil {
+		t.Fatal("expected an error when retry_max_wait is less than retry_min_wait")
+	}
+}
+
+func TestProviderMaxRetries(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    map[string]interface{}
+		env    string
+		want   int
+		wantOk bool
+	}{
+		{name: "unset", raw: map[string]interface{}{}},
+		{name: "set", raw: map[string]interface{}{"max_retries": 5}, want: 5, wantOk: true},
+		{name: "retries turned off", raw: map[string]interface{}{"max_retries": 0}, want: 0, wantOk: true},
+		{name: "from the environment", raw: map[string]interface{}{}, env: "0", want: 0, wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BOUNDARY_MAX_RETRIES", tt.env)
+			d := schema.TestResourceDataRaw(t, New().Schema, tt.raw)
+			got, ok := maxRetries(d)
+			if ok != tt.wantOk || got != tt.want {
+				t.Fatalf("expected (%d, %t), got (%d, %t)", tt.want, tt.wantOk, got, ok)
+			}
+		})
+	}
+}
+
+func TestReadRecoveryKmsHclFile(t *testing.T) {
+	const hcl = `kms "aead" {
+	purpose = ["recovery"]
+	aead_type = "aes-gcm"
+	key = "7xtkEoS5EXPbgynwd+dDLHopaCqK8cq0Rpep4eooaTs="
+	key_id = "global_recovery"
+}`
+	path := filepath.Join(t.TempDir(), "recovery.hcl")
+	if err := os.WriteFile(path, []byte(hcl), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"recovery_kms_hcl_file": path,
+	})
+	got, attr, err := readRecoveryKmsHcl(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got != hcl {
+		t.Fatalf("expected HCL from file, got %q", got)
+	}
+	if attr != "recovery_kms_hcl_file" {
+		t.Fatalf("expected attribute recovery_kms_hcl_file, got %q", attr)
+	}
+
+	d = schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"recovery_kms_hcl_file": filepath.Join(t.TempDir(), "missing.hcl"),
+	})
+	if _, _, err := readRecoveryKmsHcl(d); err == nil {
+		t.Fatal("expected an error for a missing recovery_kms_hcl_file")
+	}
+}
+
+func TestProviderEnvironment(t *testing.T) {
+	env := map[string]string{
+		"BOUNDARY_ADDR":            "https://boundary.example.com:9200",
+		"BOUNDARY_AUTH_METHOD_ID":  "ampw_1234567890",
+		"BOUNDARY_CACERT":          "/etc/boundary/ca.pem",
+		"BOUNDARY_CAPATH":          "/etc/boundary/cas",
+		"BOUNDARY_CLIENT_CERT":     "/etc/boundary/client.pem",
+		"BOUNDARY_CLIENT_KEY":      "/etc/boundary/client.key",
+		"BOUNDARY_TLS_SERVER_NAME": "boundary.internal",
+		"BOUNDARY_RECOVERY_CONFIG": "/etc/boundary/recovery.hcl",
+	}
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+	t.Setenv("BOUNDARY_TLS_INSECURE", "true")
+	t.Setenv("BOUNDARY_MAX_RETRIES", "7")
+
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{})
+	for attr, want := range map[string]string{
+		"addr":                  env["BOUNDARY_ADDR"],
+		"auth_method_id":        env["BOUNDARY_AUTH_METHOD_ID"],
+		"ca_cert":               env["BOUNDARY_CACERT"],
+		"ca_path":               env["BOUNDARY_CAPATH"],
+		"client_cert":           env["BOUNDARY_CLIENT_CERT"],
+		"client_key":            env["BOUNDARY_CLIENT_KEY"],
+		"tls_server_name":       env["BOUNDARY_TLS_SERVER_NAME"],
+		"recovery_kms_hcl_file": env["BOUNDARY_RECOVERY_CONFIG"],
+	} {
+		if got := d.Get(attr).(string); got != want {
+			t.Errorf("expected %s to be %q, got %q", attr, want, got)
+		}
+	}
+	if !d.Get("tls_insecure").(bool) {
+		t.Error("expected tls_insecure to be set from BOUNDARY_TLS_INSECURE")
+	}
+	if got := d.Get("max_retries").(int); got != 7 {
+		t.Errorf("expected max_retries to be 7, got %d", got)
+	}
+
+	// Values in the configuration take precedence over the environment
+	d = schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"addr": "http://127.0.0.1:9200",
+	})
+	if got := d.Get("addr").(string); got != "http://127.0.0.1:9200" {
+		t.Errorf("expected addr from the configuration, got %q", got)
+	}
+}
+
+func TestHcpClusterAddr(t *testing.T) {
+	const clusterId = "8f8c2e4e-2bb7-4a2b-b2c5-3e1d1b3d9c51"
+	if got, want := hcpClusterAddr(clusterId), "https://"+clusterId+".boundary.hashicorp.cloud"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
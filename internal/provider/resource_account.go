@@ -25,6 +25,12 @@ func resourceAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "accounts",
+			parentKey:        AuthMethodIdKey,
+			parentCollection: "auth-methods",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
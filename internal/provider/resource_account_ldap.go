@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	accountLdapLoginNameKey      = "login_name"
+	accountLdapFullNameKey       = "full_name"
+	accountLdapEmailKey          = "email"
+	accountLdapDnKey             = "dn"
+	accountLdapMemberOfGroupsKey = "member_of_groups"
+)
+
+func resourceAccountLdap() *schema.Resource {
+	return &schema.Resource{
+		Description: "The LDAP account resource allows you to configure a Boundary account for an LDAP auth " +
+			"method, so that LDAP users can be added to Boundary users and roles before they log in for the first time.",
+
+		CreateContext: resourceAccountLdapCreate,
+		ReadContext:   resourceAccountLdapRead,
+		UpdateContext: resourceAccountLdapUpdate,
+		DeleteContext: resourceAccountLdapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			requireControllerVersion("0.13.0", "boundary_account_ldap", nil),
+			checkPermissions(permissionCheck{
+				collection:       "accounts",
+				parentKey:        AuthMethodIdKey,
+				parentCollection: "auth-methods",
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the account.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The account name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The account description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			AuthMethodIdKey: {
+				Description: "The resource ID for the auth method.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			TypeKey: {
+				Description: "The resource type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			accountLdapLoginNameKey: {
+				Description: "The login name of the user in LDAP.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			accountLdapFullNameKey: {
+				Description: "The full name of the user, as found in LDAP when they last logged in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			accountLdapEmailKey: {
+				Description: "The email address of the user, as found in LDAP when they last logged in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			accountLdapDnKey: {
+				Description: "The distinguished name of the user, as found in LDAP when they last logged in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			accountLdapMemberOfGroupsKey: {
+				Description: "The LDAP groups the user was a member of when they last logged in.",
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func setFromAccountLdapResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	if err := d.Set(NameKey, raw["name"]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
+		return err
+	}
+	if err := d.Set(AuthMethodIdKey, raw["auth_method_id"]); err != nil {
+		return err
+	}
+	if err := d.Set(TypeKey, raw["type"]); err != nil {
+		return err
+	}
+
+	attrs, _ := raw["attributes"].(map[string]interface{})
+	for _, k := range []string{
+		accountLdapLoginNameKey,
+		accountLdapFullNameKey,
+		accountLdapEmailKey,
+		accountLdapDnKey,
+		accountLdapMemberOfGroupsKey,
+	} {
+		if err := d.Set(k, attrs[k]); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(raw["id"].(string))
+	return nil
+}
+
+func resourceAccountLdapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	var authMethodId string
+	if authMethodIdVal, ok := d.GetOk(AuthMethodIdKey); ok {
+		authMethodId = authMethodIdVal.(string)
+	} else {
+		return diag.Errorf("no auth method ID provided")
+	}
+
+	opts := []accounts.Option{
+		accounts.WithLdapAccountLoginName(d.Get(accountLdapLoginNameKey).(string)),
+	}
+	if v, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, accounts.WithName(v.(string)))
+	}
+	if v, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, accounts.WithDescription(v.(string)))
+	}
+
+	aClient := accounts.NewClient(md.client)
+
+	acr, err := aClient.Create(ctx, authMethodId, opts...)
+	if err != nil {
+		return diag.Errorf("error creating account: %v", err)
+	}
+	if acr == nil {
+		return diag.Errorf("nil account after create")
+	}
+
+	if err := setFromAccountLdapResponseMap(d, acr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAccountLdapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := accounts.NewClient(md.client)
+
+	arr, err := aClient.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading account: %v", err)
+	}
+	if arr == nil {
+		return diag.Errorf("account nil after read")
+	}
+
+	if err := setFromAccountLdapResponseMap(d, arr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAccountLdapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := accounts.NewClient(md.client)
+
+	opts := []accounts.Option{}
+
+	if d.HasChange(NameKey) {
+		opts = append(opts, accounts.DefaultName())
+		if v, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, accounts.WithName(v.(string)))
+		}
+	}
+
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, accounts.DefaultDescription())
+		if v, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, accounts.WithDescription(v.(string)))
+		}
+	}
+
+	if d.HasChange(accountLdapLoginNameKey) {
+		opts = append(opts, accounts.WithLdapAccountLoginName(d.Get(accountLdapLoginNameKey).(string)))
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, accounts.WithAutomaticVersioning(true))
+		aur, err := aClient.Update(ctx, d.Id(), 0, opts...)
+		if err != nil {
+			return diag.Errorf("error updating account: %v", err)
+		}
+		if aur == nil {
+			return diag.Errorf("account nil after update")
+		}
+
+		if err := setFromAccountLdapResponseMap(d, aur.GetResponse().Map); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAccountLdapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := accounts.NewClient(md.client)
+
+	_, err := aClient.Delete(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error deleting account: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fooAccountLdap = `
+resource "boundary_account_ldap" "foo" {
+	name           = "test"
+	description    = "test account"
+	auth_method_id = boundary_auth_method_ldap.foo.id
+	login_name     = "foo"
+}
+
+resource "boundary_user" "foo" {
+	name        = "test"
+	scope_id    = boundary_scope.org1.id
+	account_ids = [boundary_account_ldap.foo.id]
+	depends_on  = [boundary_role.org1_admin]
+}`
+
+	fooAccountLdapUpdate = `
+resource "boundary_account_ldap" "foo" {
+	name           = "test"
+	description    = "test account update"
+	auth_method_id = boundary_auth_method_ldap.foo.id
+	login_name     = "bar"
+}
+
+resource "boundary_user" "foo" {
+	name        = "test"
+	scope_id    = boundary_scope.org1.id
+	account_ids = [boundary_account_ldap.foo.id]
+	depends_on  = [boundary_role.org1_admin]
+}`
+)
+
+func TestAccAccountLdap(t *testing.T) {
+	skipForTestControllerVersion(t, "0.13.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckAccountLdapResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, fooAuthMethodLdap, fooAccountLdap),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_account_ldap.foo", "name", "test"),
+					resource.TestCheckResourceAttr("boundary_account_ldap.foo", "description", "test account"),
+					resource.TestCheckResourceAttr("boundary_account_ldap.foo", "type", "ldap"),
+					resource.TestCheckResourceAttr("boundary_account_ldap.foo", accountLdapLoginNameKey, "foo"),
+					resource.TestCheckResourceAttrPair("boundary_user.foo", "account_ids.0", "boundary_account_ldap.foo", "id"),
+					testAccCheckAccountLdapResourceExists(provider, "boundary_account_ldap.foo"),
+				),
+			},
+			importStep("boundary_account_ldap.foo"),
+			{
+				// update
+				Config: testConfig(url, fooOrg, fooAuthMethodLdap, fooAccountLdapUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_account_ldap.foo", "description", "test account update"),
+					resource.TestCheckResourceAttr("boundary_account_ldap.foo", accountLdapLoginNameKey, "bar"),
+					testAccCheckAccountLdapResourceExists(provider, "boundary_account_ldap.foo"),
+				),
+			},
+			importStep("boundary_account_ldap.foo"),
+		},
+	})
+}
+
+func testAccCheckAccountLdapResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		aClient := accounts.NewClient(md.client)
+
+		if _, err := aClient.Read(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading account %q: %w", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountLdapResourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+
+		for _, rs := range s.RootModule().Resources {
+			switch rs.Type {
+			case "boundary_account_ldap":
+				id := rs.Primary.ID
+
+				aClient := accounts.NewClient(md.client)
+
+				_, err := aClient.Read(context.Background(), id)
+				if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("didn't get a 404 when reading destroyed account %q: %v", id, err)
+				}
+
+			default:
+				continue
+			}
+		}
+		return nil
+	}
+}
+
+func TestResourceAccountLdapCreate(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/accounts", &body, map[string]interface{}{
+		"id":             "acctldap_1234567890",
+		"auth_method_id": "amldap_1234567890",
+		"type":           "ldap",
+		"name":           "test",
+		"attributes": map[string]interface{}{
+			"login_name":       "foo",
+			"full_name":        "Foo Bar",
+			"email":            "foo@example.com",
+			"dn":               "cn=foo,ou=people,dc=example,dc=com",
+			"member_of_groups": []interface{}{"cn=admins,ou=groups,dc=example,dc=com"},
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceAccountLdap().Schema, map[string]interface{}{
+		NameKey:                 "test",
+		AuthMethodIdKey:         "amldap_1234567890",
+		accountLdapLoginNameKey: "foo",
+	})
+	require.False(t, resourceAccountLdapCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "amldap_1234567890", body["auth_method_id"])
+	assert.Equal(t, map[string]interface{}{"login_name": "foo"}, body["attributes"])
+
+	assert.Equal(t, "acctldap_1234567890", d.Id())
+	assert.Equal(t, "ldap", d.Get(TypeKey))
+	assert.Equal(t, "Foo Bar", d.Get(accountLdapFullNameKey))
+	assert.Equal(t, "foo@example.com", d.Get(accountLdapEmailKey))
+	assert.Equal(t, "cn=foo,ou=people,dc=example,dc=com", d.Get(accountLdapDnKey))
+	assert.Equal(t, []interface{}{"cn=admins,ou=groups,dc=example,dc=com"}, d.Get(accountLdapMemberOfGroupsKey))
+}
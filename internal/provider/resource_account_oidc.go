@@ -30,6 +30,12 @@ func resourceAccountOidc() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "accounts",
+			parentKey:        AuthMethodIdKey,
+			parentCollection: "auth-methods",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
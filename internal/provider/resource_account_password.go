@@ -5,18 +5,37 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/accounts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	accountTypePassword = "password"
-	accountLoginNameKey = "login_name"
-	accountPasswordKey  = "password"
+	accountTypePassword                    = "password"
+	accountLoginNameKey                    = "login_name"
+	accountPasswordKey                     = "password"
+	accountGeneratePasswordKey             = "generate_password"
+	accountGeneratePasswordLengthKey       = "generate_password_length"
+	accountGeneratePasswordSpecialCharsKey = "generate_password_special_characters"
+	accountGeneratedPasswordKey            = "generated_password"
+
+	accountGeneratedPasswordDefaultLength = 32
+	// Boundary rejects passwords shorter than this
+	accountPasswordMinLength = 8
+
+	passwordCharsLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordCharsUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordCharsDigits  = "0123456789"
+	passwordCharsSpecial = "!#$%&*()-_=+[]{}<>:?"
 )
 
 func resourceAccountPassword() *schema.Resource {
@@ -28,8 +47,17 @@ func resourceAccountPassword() *schema.Resource {
 		UpdateContext: resourceAccountPasswordUpdate,
 		DeleteContext: resourceAccountPasswordDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceAccountPasswordImport,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffAccountGeneratedPassword,
+			checkPermissions(permissionCheck{
+				collection:       "accounts",
+				parentKey:        AuthMethodIdKey,
+				parentCollection: "auth-methods",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -65,22 +93,119 @@ func resourceAccountPassword() *schema.Resource {
 				Optional:    true,
 			},
 			accountPasswordKey: {
-				Description: "The account password. Only set on create, changes will not be reflected when updating account.",
+				Description: "The account password. Changing it sets the new password on the existing account. " +
+					"Cannot be used together with `generate_password`.",
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			accountGeneratePasswordKey: {
+				Description: "Whether the provider generates a random password for the account, " +
+					"which is then available in `generated_password`. A new password is generated " +
+					"whenever the generation settings change.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			accountGeneratePasswordLengthKey: {
+				Description:  "The length of the generated password. Defaults to 32.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      accountGeneratedPasswordDefaultLength,
+				ValidateFunc: validation.IntAtLeast(accountPasswordMinLength),
+			},
+			accountGeneratePasswordSpecialCharsKey: {
+				Description: "Whether the generated password contains special characters in addition to " +
+					"letters and digits. Defaults to true.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			accountGeneratedPasswordKey: {
+				Description: "The password generated by the provider when `generate_password` is set.",
 				Type:        schema.TypeString,
-				Optional:    true,
+				Computed:    true,
 				Sensitive:   true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Id() == "" {
-						// This is a new resource do not suppress password diff
-						return false
-					}
-					return true
-				},
 			},
 		},
 	}
 }
 
+// customizeDiffAccountGeneratedPassword makes sure the password is not both
+// set and generated, and marks the generated password as unknown when a new
+// one will be generated, or removed, during the update.
+func customizeDiffAccountGeneratedPassword(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get(accountGeneratePasswordKey).(bool) && d.Get(accountPasswordKey).(string) != "" {
+		return fmt.Errorf(`"password" cannot be set when "generate_password" is true`)
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.HasChange(accountGeneratePasswordKey) && (!d.Get(accountGeneratePasswordKey).(bool) ||
+		!d.HasChanges(accountGeneratePasswordLengthKey, accountGeneratePasswordSpecialCharsKey)) {
+		return nil
+	}
+	return d.SetNewComputed(accountGeneratedPasswordKey)
+}
+
+// generatePassword returns a random password of the given length with at
+// least one lower case letter, upper case letter and digit, and one special
+// character when special is set.
+func generatePassword(length int, special bool) (string, error) {
+	classes := []string{passwordCharsLower, passwordCharsUpper, passwordCharsDigits}
+	if special {
+		classes = append(classes, passwordCharsSpecial)
+	}
+	if length < len(classes) {
+		return "", fmt.Errorf("password length %d is too short to contain all character classes", length)
+	}
+	charset := strings.Join(classes, "")
+
+	randomIndex := func(n int) (int, error) {
+		i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+		if err != nil {
+			return 0, fmt.Errorf("error generating password: %w", err)
+		}
+		return int(i.Int64()), nil
+	}
+
+	// Pick one character of every class first, so the password is accepted by
+	// policies requiring them, then fill it up and shuffle
+	password := make([]byte, 0, length)
+	for _, c := range classes {
+		i, err := randomIndex(len(c))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c[i])
+	}
+	for len(password) < length {
+		i, err := randomIndex(len(charset))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, charset[i])
+	}
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// generateAccountPassword generates a password following the settings of the
+// account.
+func generateAccountPassword(d *schema.ResourceData) (string, error) {
+	return generatePassword(
+		d.Get(accountGeneratePasswordLengthKey).(int),
+		d.Get(accountGeneratePasswordSpecialCharsKey).(bool),
+	)
+}
+
 func setFromAccountPasswordResponseMap(d *schema.ResourceData, raw map[string]interface{}) {
 	d.Set(NameKey, raw["name"])
 	d.Set(DescriptionKey, raw["description"])
@@ -98,6 +223,15 @@ func setFromAccountPasswordResponseMap(d *schema.ResourceData, raw map[string]in
 	d.SetId(raw["id"].(string))
 }
 
+func resourceAccountPasswordImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// The password generation settings are not stored by Boundary, start from
+	// their defaults
+	d.Set(accountGeneratePasswordKey, false)
+	d.Set(accountGeneratePasswordLengthKey, accountGeneratedPasswordDefaultLength)
+	d.Set(accountGeneratePasswordSpecialCharsKey, true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAccountPasswordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 
@@ -120,6 +254,15 @@ func resourceAccountPasswordCreate(ctx context.Context, d *schema.ResourceData,
 		password = &key
 	}
 
+	var generatedPassword *string
+	if d.Get(accountGeneratePasswordKey).(bool) {
+		generated, err := generateAccountPassword(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		generatedPassword = &generated
+	}
+
 	opts := []accounts.Option{}
 
 	var typeStr string
@@ -137,6 +280,9 @@ func resourceAccountPasswordCreate(ctx context.Context, d *schema.ResourceData,
 			opts = append(opts, accounts.WithPasswordAccountPassword(*password))
 			d.Set(accountPasswordKey, *password)
 		}
+		if generatedPassword != nil {
+			opts = append(opts, accounts.WithPasswordAccountPassword(*generatedPassword))
+		}
 	default:
 		return diag.Errorf("invalid type provided")
 	}
@@ -164,6 +310,9 @@ func resourceAccountPasswordCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	setFromAccountPasswordResponseMap(d, acr.GetResponse().Map)
+	if generatedPassword != nil {
+		d.Set(accountGeneratedPasswordKey, *generatedPassword)
+	}
 
 	return nil
 }
@@ -241,6 +390,42 @@ func resourceAccountPasswordUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// The password can't be changed with a regular update, it has its own
+	// endpoint so it can be rotated without recreating the account
+	var password *string
+	generate := d.Get(accountGeneratePasswordKey).(bool)
+	switch {
+	case generate && d.HasChanges(accountGeneratePasswordKey, accountGeneratePasswordLengthKey, accountGeneratePasswordSpecialCharsKey):
+		generated, err := generateAccountPassword(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		password = &generated
+	case !generate && d.HasChange(accountPasswordKey):
+		// Removing the password from the configuration leaves the current one
+		if passwordVal, ok := d.GetOk(accountPasswordKey); ok {
+			passwordStr := passwordVal.(string)
+			password = &passwordStr
+		}
+	}
+	if password != nil {
+		switch d.Get(TypeKey).(string) {
+		case accountTypePassword:
+			_, err := aClient.SetPassword(ctx, d.Id(), *password, 0, accounts.WithAutomaticVersioning(true))
+			if err != nil {
+				return diag.Errorf("error setting account password: %v", err)
+			}
+		default:
+			return diag.Errorf(`"password" cannot be used with this type of account`)
+		}
+	}
+	switch {
+	case generate && password != nil:
+		d.Set(accountGeneratedPasswordKey, *password)
+	case !generate && d.HasChange(accountGeneratePasswordKey):
+		d.Set(accountGeneratedPasswordKey, "")
+	}
+
 	if d.HasChange(NameKey) {
 		d.Set(NameKey, name)
 	}
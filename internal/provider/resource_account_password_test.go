@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/testing/controller"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -55,7 +59,7 @@ resource "boundary_account_password" "foo" {
 	description    = "%s"
 	type           = "password"
 	login_name     = "foo"
-	password       = "foofoofoo"
+	password       = "barbarbar"
 	auth_method_id = boundary_auth_method.foo.id
 }`, fooAccountPasswordDescUpdate)
 )
@@ -92,7 +96,8 @@ func TestAccAccount(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_account_password.foo", "name", "test"),
 					resource.TestCheckResourceAttr("boundary_account_password.foo", "type", "password"),
 					resource.TestCheckResourceAttr("boundary_account_password.foo", "login_name", "foo"),
-					resource.TestCheckResourceAttr("boundary_account_password.foo", "password", "foofoofoo"),
+					resource.TestCheckResourceAttr("boundary_account_password.foo", "password", "barbarbar"),
+					testAccCheckAccountPasswordAuthenticates(provider, "boundary_account_password.foo", accountPasswordKey),
 					testAccCheckAccountPasswordResourceExists(provider, "boundary_account_password.foo"),
 				),
 			},
@@ -101,6 +106,121 @@ func TestAccAccount(t *testing.T) {
 	})
 }
 
+func TestAccAccountPasswordGenerated(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	config := func(length int, special bool) string {
+		return fmt.Sprintf(`
+resource "boundary_auth_method" "foo" {
+	name        = "test"
+	description = "test account"
+	type        = "password"
+	scope_id    = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+resource "boundary_account_password" "foo" {
+	name                                 = "test"
+	type                                 = "password"
+	login_name                           = "foo"
+	auth_method_id                       = boundary_auth_method.foo.id
+	generate_password                    = true
+	generate_password_length             = %d
+	generate_password_special_characters = %t
+}`, length, special)
+	}
+
+	var firstPassword string
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckAccountPasswordResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, config(16, true)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("boundary_account_password.foo", accountGeneratedPasswordKey, func(value string) error {
+						if len(value) != 16 {
+							return fmt.Errorf("expected a password of 16 characters, got %d", len(value))
+						}
+						firstPassword = value
+						return nil
+					}),
+					testAccCheckAccountPasswordAuthenticates(provider, "boundary_account_password.foo", accountGeneratedPasswordKey),
+				),
+			},
+			importStep("boundary_account_password.foo", accountGeneratedPasswordKey, accountGeneratePasswordKey, accountGeneratePasswordLengthKey, accountGeneratePasswordSpecialCharsKey),
+			{
+				// changing the settings rotates the password
+				Config: testConfig(url, fooOrg, config(24, false)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("boundary_account_password.foo", accountGeneratedPasswordKey, func(value string) error {
+						if len(value) != 24 {
+							return fmt.Errorf("expected a password of 24 characters, got %d", len(value))
+						}
+						if value == firstPassword {
+							return fmt.Errorf("expected a new password to be generated")
+						}
+						if strings.ContainsAny(value, passwordCharsSpecial) {
+							return fmt.Errorf("expected no special characters, got %q", value)
+						}
+						return nil
+					}),
+					testAccCheckAccountPasswordAuthenticates(provider, "boundary_account_password.foo", accountGeneratedPasswordKey),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomizeDiffAccountGeneratedPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		generate bool
+		wantErr  string
+	}{
+		{name: "password", password: "foofoofoo"},
+		{name: "generated", generate: true},
+		{name: "both", password: "foofoofoo", generate: true, wantErr: `"password" cannot be set when "generate_password" is true`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				AuthMethodIdKey:            "ampw_1234567890",
+				TypeKey:                    accountTypePassword,
+				accountGeneratePasswordKey: tt.generate,
+			}
+			if tt.password != "" {
+				raw[accountPasswordKey] = tt.password
+			}
+			_, err := resourceAccountPassword().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGeneratePassword(t *testing.T) {
+	for _, special := range []bool{true, false} {
+		password, err := generatePassword(accountPasswordMinLength, special)
+		require.NoError(t, err)
+		assert.Len(t, password, accountPasswordMinLength)
+		assert.True(t, strings.ContainsAny(password, passwordCharsLower))
+		assert.True(t, strings.ContainsAny(password, passwordCharsUpper))
+		assert.True(t, strings.ContainsAny(password, passwordCharsDigits))
+		assert.Equal(t, special, strings.ContainsAny(password, passwordCharsSpecial))
+	}
+
+	_, err := generatePassword(3, true)
+	assert.Error(t, err)
+}
+
 func testAccCheckAccountPasswordResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -125,6 +245,31 @@ func testAccCheckAccountPasswordResourceExists(testProvider *schema.Provider, na
 	}
 }
 
+// testAccCheckAccountPasswordAuthenticates checks the account can log in with
+// the password found in the given attribute.
+func testAccCheckAccountPasswordAuthenticates(testProvider *schema.Provider, name, passwordKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+
+		amClient := authmethods.NewClient(md.client)
+
+		attrs := map[string]interface{}{
+			"login_name": rs.Primary.Attributes[accountLoginNameKey],
+			"password":   rs.Primary.Attributes[passwordKey],
+		}
+		if _, err := amClient.Authenticate(context.Background(), rs.Primary.Attributes[AuthMethodIdKey], "login", attrs); err != nil {
+			return fmt.Errorf("Got an error when authenticating with account %q: %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAccountPasswordResourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if testProvider.Meta() == nil {
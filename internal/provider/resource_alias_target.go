@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	aliasTypeTarget = "target"
+
+	aliasValueKey                  = "value"
+	aliasDestinationIdKey          = "destination_id"
+	aliasAuthorizeSessionHostIdKey = "authorize_session_host_id"
+)
+
+func resourceAliasTarget() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target alias resource allows you to configure a Boundary target alias. An alias " +
+			"is a globally unique value, such as a DNS-like name, that can be used instead of a target ID, " +
+			"e.g. `boundary connect ssh alias.example.com`. Aliases are always part of the global scope.",
+
+		CreateContext: resourceAliasTargetCreate,
+		ReadContext:   resourceAliasTargetRead,
+		UpdateContext: resourceAliasTargetUpdate,
+		DeleteContext: resourceAliasTargetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			requireControllerVersion("0.16.0", "boundary_alias_target", nil),
+			checkPermissions(permissionCheck{
+				collection:       "aliases",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the alias.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The alias name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The alias description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope ID in which the alias is created. Defaults to `global`, the only scope aliases can be created in.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "global",
+			},
+			TypeKey: {
+				Description: "The type of the alias.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			aliasValueKey: {
+				Description: "The value of the alias, e.g. `alias.example.com`. It must be unique across all aliases.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			aliasDestinationIdKey: {
+				Description: "The ID of the target the alias points to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			aliasAuthorizeSessionHostIdKey: {
+				Description: "The ID of the host to connect to when a session is authorized through the alias. The host must be part of one of the host sources of the target.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func setFromAliasTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	if err := d.Set(NameKey, raw["name"]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
+		return err
+	}
+	if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
+		return err
+	}
+	if err := d.Set(TypeKey, raw["type"]); err != nil {
+		return err
+	}
+	if err := d.Set(aliasValueKey, raw["value"]); err != nil {
+		return err
+	}
+	if err := d.Set(aliasDestinationIdKey, raw["destination_id"]); err != nil {
+		return err
+	}
+
+	var hostId interface{}
+	if attrs, ok := raw["attributes"].(map[string]interface{}); ok {
+		if args, ok := attrs["authorize_session_arguments"].(map[string]interface{}); ok {
+			hostId = args["host_id"]
+		}
+	}
+	if err := d.Set(aliasAuthorizeSessionHostIdKey, hostId); err != nil {
+		return err
+	}
+
+	d.SetId(raw["id"].(string))
+	return nil
+}
+
+func resourceAliasTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	opts := []aliases.Option{
+		aliases.WithValue(d.Get(aliasValueKey).(string)),
+	}
+	if nameVal, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, aliases.WithName(nameVal.(string)))
+	}
+	if descVal, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, aliases.WithDescription(descVal.(string)))
+	}
+	if destVal, ok := d.GetOk(aliasDestinationIdKey); ok {
+		opts = append(opts, aliases.WithDestinationId(destVal.(string)))
+	}
+	if hostVal, ok := d.GetOk(aliasAuthorizeSessionHostIdKey); ok {
+		opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(hostVal.(string)))
+	}
+
+	aClient := aliases.NewClient(md.client)
+
+	acr, err := aClient.Create(ctx, aliasTypeTarget, d.Get(ScopeIdKey).(string), opts...)
+	if err != nil {
+		return diag.Errorf("error creating alias: %v", err)
+	}
+	if acr == nil {
+		return diag.Errorf("alias nil after create")
+	}
+
+	if err := setFromAliasTargetResponseMap(d, acr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAliasTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := aliases.NewClient(md.client)
+
+	arr, err := aClient.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading alias: %v", err)
+	}
+	if arr == nil {
+		return diag.Errorf("alias nil after read")
+	}
+
+	if err := setFromAliasTargetResponseMap(d, arr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAliasTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := aliases.NewClient(md.client)
+
+	opts := []aliases.Option{}
+
+	if d.HasChange(NameKey) {
+		opts = append(opts, aliases.DefaultName())
+		if nameVal, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, aliases.WithName(nameVal.(string)))
+		}
+	}
+
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, aliases.DefaultDescription())
+		if descVal, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, aliases.WithDescription(descVal.(string)))
+		}
+	}
+
+	if d.HasChange(aliasValueKey) {
+		opts = append(opts, aliases.WithValue(d.Get(aliasValueKey).(string)))
+	}
+
+	if d.HasChange(aliasDestinationIdKey) {
+		opts = append(opts, aliases.DefaultDestinationId())
+		if destVal, ok := d.GetOk(aliasDestinationIdKey); ok {
+			opts = append(opts, aliases.WithDestinationId(destVal.(string)))
+		}
+	}
+
+	if d.HasChange(aliasAuthorizeSessionHostIdKey) {
+		opts = append(opts, aliases.DefaultTargetAliasAuthorizeSessionArgumentsHostId())
+		if hostVal, ok := d.GetOk(aliasAuthorizeSessionHostIdKey); ok {
+			opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(hostVal.(string)))
+		}
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, aliases.WithAutomaticVersioning(true))
+		aur, err := aClient.Update(ctx, d.Id(), 0, opts...)
+		if err != nil {
+			return diag.Errorf("error updating alias: %v", err)
+		}
+		if aur == nil {
+			return diag.Errorf("alias nil after update")
+		}
+
+		if err := setFromAliasTargetResponseMap(d, aur.GetResponse().Map); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAliasTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	aClient := aliases.NewClient(md.client)
+
+	_, err := aClient.Delete(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error deleting alias: %v", err)
+	}
+
+	return nil
+}
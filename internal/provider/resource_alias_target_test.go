@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fooAliasValue       = "foo.example.com"
+	fooAliasValueUpdate = "bar.example.com"
+)
+
+var (
+	aliasTargetTarget = `
+resource "boundary_target" "foo" {
+	name         = "test"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooAliasTarget = fmt.Sprintf(`
+resource "boundary_alias_target" "foo" {
+	name           = "test"
+	description    = "test alias"
+	value          = "%s"
+	destination_id = boundary_target.foo.id
+}`, fooAliasValue)
+
+	fooAliasTargetUpdate = fmt.Sprintf(`
+resource "boundary_alias_target" "foo" {
+	name        = "test"
+	description = "test alias updated"
+	value       = "%s"
+}`, fooAliasValueUpdate)
+)
+
+func TestAccAliasTarget(t *testing.T) {
+	skipForTestControllerVersion(t, "0.16.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckAliasTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, firstProjectFoo, aliasTargetTarget, fooAliasTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasTargetResourceExists(provider, "boundary_alias_target.foo"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "name", "test"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "description", "test alias"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "scope_id", "global"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "type", "target"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "value", fooAliasValue),
+					resource.TestCheckResourceAttrPair("boundary_alias_target.foo", "destination_id", "boundary_target.foo", "id"),
+				),
+			},
+			importStep("boundary_alias_target.foo"),
+			{
+				// update, removing the destination
+				Config: testConfig(url, fooOrg, firstProjectFoo, aliasTargetTarget, fooAliasTargetUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasTargetResourceExists(provider, "boundary_alias_target.foo"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "description", "test alias updated"),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "value", fooAliasValueUpdate),
+					resource.TestCheckResourceAttr("boundary_alias_target.foo", "destination_id", ""),
+				),
+			},
+			importStep("boundary_alias_target.foo"),
+		},
+	})
+}
+
+func testAccCheckAliasTargetResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		aClient := aliases.NewClient(md.client)
+
+		if _, err := aClient.Read(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading alias %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAliasTargetResourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+
+		for _, rs := range s.RootModule().Resources {
+			switch rs.Type {
+			case "boundary_alias_target":
+				aClient := aliases.NewClient(md.client)
+
+				id := rs.Primary.ID
+
+				_, err := aClient.Read(context.Background(), id)
+				if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("didn't get a 404 when reading destroyed alias %q: %v", id, apiErr)
+				}
+
+			default:
+				continue
+			}
+		}
+		return nil
+	}
+}
+
+func TestResourceAliasTargetCreate(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/aliases", &body, map[string]interface{}{
+		"id":             "alt_1234567890",
+		"scope_id":       "global",
+		"type":           "target",
+		"name":           "test",
+		"description":    "test alias",
+		"value":          fooAliasValue,
+		"destination_id": "ttcp_1234567890",
+		"attributes": map[string]interface{}{
+			"authorize_session_arguments": map[string]interface{}{
+				"host_id": "hst_1234567890",
+			},
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceAliasTarget().Schema, map[string]interface{}{
+		NameKey:                        "test",
+		DescriptionKey:                 "test alias",
+		aliasValueKey:                  fooAliasValue,
+		aliasDestinationIdKey:          "ttcp_1234567890",
+		aliasAuthorizeSessionHostIdKey: "hst_1234567890",
+	})
+	require.False(t, resourceAliasTargetCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "target", body["type"])
+	assert.Equal(t, "global", body["scope_id"])
+	assert.Equal(t, fooAliasValue, body["value"])
+	assert.Equal(t, "ttcp_1234567890", body["destination_id"])
+	assert.Equal(t, map[string]interface{}{
+		"authorize_session_arguments": map[string]interface{}{"host_id": "hst_1234567890"},
+	}, body["attributes"])
+
+	assert.Equal(t, "alt_1234567890", d.Id())
+	assert.Equal(t, "target", d.Get(TypeKey))
+	assert.Equal(t, "hst_1234567890", d.Get(aliasAuthorizeSessionHostIdKey))
+}
+
+func TestSetFromAliasTargetResponseMap(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAliasTarget().Schema, map[string]interface{}{
+		aliasValueKey:                  fooAliasValue,
+		aliasAuthorizeSessionHostIdKey: "hst_1234567890",
+	})
+	// The controller leaves out the attributes once the host is removed
+	require.NoError(t, setFromAliasTargetResponseMap(d, map[string]interface{}{
+		"id":       "alt_1234567890",
+		"scope_id": "global",
+		"type":     "target",
+		"value":    fooAliasValueUpdate,
+	}))
+	assert.Equal(t, fooAliasValueUpdate, d.Get(aliasValueKey))
+	assert.Equal(t, "", d.Get(aliasDestinationIdKey))
+	assert.Equal(t, "", d.Get(aliasAuthorizeSessionHostIdKey))
+}
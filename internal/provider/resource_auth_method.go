@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -26,6 +27,15 @@ func resourceAuthMethod() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			checkPermissions(permissionCheck{
+				collection:       "auth-methods",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -44,9 +54,10 @@ func resourceAuthMethod() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
+				Description: "The scope ID." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			TypeKey: {
@@ -169,9 +180,8 @@ func resourceAuthMethodCreate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceAuthMethodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
-	amClient := authmethods.NewClient(md.client)
 
-	amrr, err := amClient.Read(ctx, d.Id())
+	raw, err := md.readAuthMethod(ctx, d.Id())
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr.Response().StatusCode() == http.StatusNotFound {
 			d.SetId("")
@@ -179,11 +189,11 @@ func resourceAuthMethodRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 		return diag.Errorf("error reading auth method: %v", err)
 	}
-	if amrr == nil {
+	if raw == nil {
 		return diag.Errorf("auth method nil after read")
 	}
 
-	if err := setFromAuthMethodResponseMap(d, amrr.GetResponse().Map); err != nil {
+	if err := setFromAuthMethodResponseMap(d, raw); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -227,6 +237,7 @@ func resourceAuthMethodUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	opts = append(opts, authmethods.WithAutomaticVersioning(true))
 	amu, err := amClient.Update(ctx, d.Id(), 0, opts...)
+	md.cache.invalidate(d.Id())
 	if err != nil {
 		return diag.Errorf("error updating auth method: %v", err)
 	}
@@ -241,6 +252,9 @@ func resourceAuthMethodDelete(ctx context.Context, d *schema.ResourceData, meta
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
+	md.cache.invalidate(d.Id())
+	// The scope drops the auth method if it was its primary one
+	md.cache.invalidate(d.Get(ScopeIdKey).(string))
 	if err != nil {
 		return diag.Errorf("error deleting auth method: %v", err)
 	}
@@ -0,0 +1,634 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	authmethodTypeLdap                         = "ldap"
+	authmethodLdapUrlsKey                      = "urls"
+	authmethodLdapStartTlsKey                  = "start_tls"
+	authmethodLdapInsecureTlsKey               = "insecure_tls"
+	authmethodLdapDiscoverDnKey                = "discover_dn"
+	authmethodLdapAnonGroupSearchKey           = "anon_group_search"
+	authmethodLdapUpnDomainKey                 = "upn_domain"
+	authmethodLdapUserDnKey                    = "user_dn"
+	authmethodLdapUserAttrKey                  = "user_attr"
+	authmethodLdapUserFilterKey                = "user_filter"
+	authmethodLdapEnableGroupsKey              = "enable_groups"
+	authmethodLdapGroupDnKey                   = "group_dn"
+	authmethodLdapGroupAttrKey                 = "group_attr"
+	authmethodLdapGroupFilterKey               = "group_filter"
+	authmethodLdapCertificatesKey              = "certificates"
+	authmethodLdapClientCertificateKey         = "client_certificate"
+	authmethodLdapClientCertificateKeyKey      = "client_certificate_key"
+	authmethodLdapBindDnKey                    = "bind_dn"
+	authmethodLdapBindPasswordKey              = "bind_password"
+	authmethodLdapUseTokenGroupsKey            = "use_token_groups"
+	authmethodLdapAccountAttributeMapsKey      = "account_attribute_maps"
+	authmethodLdapStateKey                     = "state"
+	authmethodLdapIsPrimaryAuthMethodForScope  = "is_primary_for_scope"
+	authmethodLdapClientCertificateKeyHmacKey  = "client_certificate_key_hmac"
+	authmethodLdapBindPasswordHmacKey          = "bind_password_hmac"
+	authmethodLdapStateActivePublic            = "active-public"
+	authmethodLdapChangedInBoundaryPlaceholder = "(changed in Boundary)"
+)
+
+// authmethodLdapStringAttrs and authmethodLdapBoolAttrs are the attributes of
+// the auth method that are read back from the controller as they are.
+var (
+	authmethodLdapStringAttrs = []string{
+		authmethodLdapUpnDomainKey,
+		authmethodLdapUserDnKey,
+		authmethodLdapUserAttrKey,
+		authmethodLdapUserFilterKey,
+		authmethodLdapGroupDnKey,
+		authmethodLdapGroupAttrKey,
+		authmethodLdapGroupFilterKey,
+		authmethodLdapClientCertificateKey,
+		authmethodLdapBindDnKey,
+		authmethodLdapStateKey,
+	}
+	authmethodLdapBoolAttrs = []string{
+		authmethodLdapStartTlsKey,
+		authmethodLdapInsecureTlsKey,
+		authmethodLdapDiscoverDnKey,
+		authmethodLdapAnonGroupSearchKey,
+		authmethodLdapEnableGroupsKey,
+		authmethodLdapUseTokenGroupsKey,
+	}
+	authmethodLdapListAttrs = []string{
+		authmethodLdapUrlsKey,
+		authmethodLdapCertificatesKey,
+		authmethodLdapAccountAttributeMapsKey,
+	}
+)
+
+func resourceAuthMethodLdap() *schema.Resource {
+	return &schema.Resource{
+		Description: "The LDAP auth method resource allows you to configure a Boundary auth_method_ldap.",
+
+		CreateContext: resourceAuthMethodLdapCreate,
+		ReadContext:   resourceAuthMethodLdapRead,
+		UpdateContext: resourceAuthMethodLdapUpdate,
+		DeleteContext: resourceAuthMethodLdapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			requireControllerVersion("0.13.0", "boundary_auth_method_ldap", nil),
+			checkPermissions(permissionCheck{
+				collection:       "auth-methods",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the auth method.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The auth method name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The auth method description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope ID." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			TypeKey: {
+				Description: "The type of auth method; hardcoded.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			// LDAP specific configurable parameters
+			authmethodLdapUrlsKey: {
+				Description: "The LDAP URLs that specify LDAP servers to connect to, e.g. `ldaps://ldap.example.com`. At least one is required.",
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MinItems:    1,
+			},
+			authmethodLdapStartTlsKey: {
+				Description: "Issue a StartTLS command after connecting. Should not be used with `ldaps` URLs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			authmethodLdapInsecureTlsKey: {
+				Description: "Skip the verification of the certificate of the LDAP server. Not recommended.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			authmethodLdapDiscoverDnKey: {
+				Description: "Use anonymous bind to discover the bind DN of a user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			authmethodLdapAnonGroupSearchKey: {
+				Description: "Use anonymous bind when performing LDAP group searches.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			authmethodLdapUpnDomainKey: {
+				Description: "The userPrincipalDomain used to construct the UPN string for the authenticating user, e.g. `example.com`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapUserDnKey: {
+				Description: "The base DN under which to perform user searches, e.g. `ou=Users,dc=example,dc=com`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapUserAttrKey: {
+				Description: "The attribute on user entries matching the login name, e.g. `uid`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapUserFilterKey: {
+				Description: "A go template used to construct an LDAP user search filter, e.g. `({{.UserAttr}}={{.Username}})`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapEnableGroupsKey: {
+				Description: "Find the groups of a user when they authenticate, which is required for LDAP managed groups.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			authmethodLdapGroupDnKey: {
+				Description: "The base DN under which to perform group searches, e.g. `ou=Groups,dc=example,dc=com`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapGroupAttrKey: {
+				Description: "The attribute that enumerates the group membership of a user, e.g. `memberOf`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapGroupFilterKey: {
+				Description: "A go template used to construct an LDAP group search filter, e.g. `(&(objectClass=group)(member:1.2.840.113556.1.4.1941:={{.UserDN}}))`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapCertificatesKey: {
+				Description: "PEM-encoded X.509 CA certificates to trust when verifying the certificate of the LDAP server.",
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			authmethodLdapClientCertificateKey: {
+				Description: "A PEM-encoded X.509 client certificate to present to the LDAP server, together with `client_certificate_key`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapClientCertificateKeyKey: {
+				Description:  "The PEM-encoded private key of `client_certificate`. Once set, only the HMAC is kept by Boundary.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{authmethodLdapClientCertificateKey},
+			},
+			authmethodLdapBindDnKey: {
+				Description: "The distinguished name of the entry to bind with when performing user and group searches, e.g. `cn=boundary,ou=Users,dc=example,dc=com`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			authmethodLdapBindPasswordKey: {
+				Description:  "The password of `bind_dn`. Once set, only the HMAC is kept by Boundary.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{authmethodLdapBindDnKey},
+			},
+			authmethodLdapUseTokenGroupsKey: {
+				Description: "Use the Active Directory tokenGroups constructed attribute of the user to find the group memberships. This finds all security groups, including nested ones.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			authmethodLdapAccountAttributeMapsKey: {
+				Description: "Attribute maps from custom LDAP attributes to the standard account attributes, in the form `<from>=<to>`, e.g. `sn=fullName`. Only `email` and `fullName` can be mapped to.",
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			authmethodLdapStateKey: {
+				Description:  "The state of the auth method, one of `inactive`, `active-private` or `active-public`. Defaults to `active-public`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      authmethodLdapStateActivePublic,
+				ValidateFunc: validation.StringInSlice([]string{"inactive", "active-private", authmethodLdapStateActivePublic}, false),
+			},
+			authmethodLdapIsPrimaryAuthMethodForScope: {
+				Description: "When true, makes this auth method the primary auth method for the scope in which it resides. The primary auth method for a scope means the user will be automatically created when they login using an LDAP account.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+
+			// LDAP specific computed parameters
+			authmethodLdapBindPasswordHmacKey: {
+				Description: "The HMAC of the bind password returned by the Boundary controller, which is used to detect changes made outside of Terraform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			authmethodLdapClientCertificateKeyHmacKey: {
+				Description: "The HMAC of the client certificate key returned by the Boundary controller, which is used to detect changes made outside of Terraform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func setFromLdapAuthMethodResponseMap(d *schema.ResourceData, raw map[string]interface{}, fromRead bool) error {
+	if err := d.Set(NameKey, raw[NameKey]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw[DescriptionKey]); err != nil {
+		return err
+	}
+	if err := d.Set(ScopeIdKey, raw[ScopeIdKey]); err != nil {
+		return err
+	}
+	if err := d.Set(TypeKey, raw[TypeKey]); err != nil {
+		return err
+	}
+	isPrimary, _ := raw[authmethodLdapIsPrimaryAuthMethodForScope].(bool)
+	if err := d.Set(authmethodLdapIsPrimaryAuthMethodForScope, isPrimary); err != nil {
+		return err
+	}
+
+	attrs, _ := raw["attributes"].(map[string]interface{})
+	for _, k := range authmethodLdapStringAttrs {
+		if err := d.Set(k, attrs[k]); err != nil {
+			return err
+		}
+	}
+	for _, k := range authmethodLdapBoolAttrs {
+		// The controller leaves out false values
+		v, _ := attrs[k].(bool)
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	for _, k := range authmethodLdapListAttrs {
+		if err := d.Set(k, attrs[k]); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range []struct {
+		secretKey, hmacKey string
+	}{
+		{authmethodLdapBindPasswordKey, authmethodLdapBindPasswordHmacKey},
+		{authmethodLdapClientCertificateKeyKey, authmethodLdapClientCertificateKeyHmacKey},
+	} {
+		stateHmac := d.Get(s.hmacKey).(string)
+		boundaryHmac, _ := attrs[s.hmacKey].(string)
+		if fromRead && stateHmac != "" && stateHmac != boundaryHmac {
+			// The secret has been changed in Boundary, update its value to
+			// force tf to attempt an update
+			if err := d.Set(s.secretKey, authmethodLdapChangedInBoundaryPlaceholder); err != nil {
+				return err
+			}
+		}
+		if err := d.Set(s.hmacKey, boundaryHmac); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(raw["id"].(string))
+	return nil
+}
+
+// expandStringList converts a TypeList of strings to the form the API expects.
+func expandStringList(raw []interface{}) []string {
+	l := make([]string, 0, len(raw))
+	for _, v := range raw {
+		l = append(l, v.(string))
+	}
+	return l
+}
+
+func resourceAuthMethodLdapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	var scopeId string
+	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
+		scopeId = scopeIdVal.(string)
+	} else {
+		return diag.Errorf("no scope ID provided")
+	}
+
+	opts := []authmethods.Option{
+		authmethods.WithLdapAuthMethodUrls(expandStringList(d.Get(authmethodLdapUrlsKey).([]interface{}))),
+		authmethods.WithLdapAuthMethodState(d.Get(authmethodLdapStateKey).(string)),
+	}
+	if v, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, authmethods.WithName(v.(string)))
+	}
+	if v, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, authmethods.WithDescription(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapStartTlsKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodStartTls(v.(bool)))
+	}
+	if v, ok := d.GetOk(authmethodLdapInsecureTlsKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodInsecureTls(v.(bool)))
+	}
+	if v, ok := d.GetOk(authmethodLdapDiscoverDnKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodDiscoverDn(v.(bool)))
+	}
+	if v, ok := d.GetOk(authmethodLdapAnonGroupSearchKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodAnonGroupSearch(v.(bool)))
+	}
+	if v, ok := d.GetOk(authmethodLdapUpnDomainKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodUpnDomain(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapUserDnKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodUserDn(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapUserAttrKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodUserAttr(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapUserFilterKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodUserFilter(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapEnableGroupsKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodEnableGroups(v.(bool)))
+	}
+	if v, ok := d.GetOk(authmethodLdapGroupDnKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodGroupDn(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapGroupAttrKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodGroupAttr(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapGroupFilterKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodGroupFilter(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapCertificatesKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodCertificates(expandStringList(v.([]interface{}))))
+	}
+	if v, ok := d.GetOk(authmethodLdapClientCertificateKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodClientCertificate(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapClientCertificateKeyKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodClientCertificateKey(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapBindDnKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodBindDn(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapBindPasswordKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodBindPassword(v.(string)))
+	}
+	if v, ok := d.GetOk(authmethodLdapUseTokenGroupsKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodUseTokenGroups(v.(bool)))
+	}
+	if v, ok := d.GetOk(authmethodLdapAccountAttributeMapsKey); ok {
+		opts = append(opts, authmethods.WithLdapAuthMethodAccountAttributeMaps(expandStringList(v.([]interface{}))))
+	}
+
+	amClient := authmethods.NewClient(md.client)
+
+	amcr, err := amClient.Create(ctx, authmethodTypeLdap, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error creating auth method: %v", err)
+	}
+	if amcr == nil {
+		return diag.Errorf("nil auth method after create")
+	}
+	raw := amcr.GetResponse().Map
+
+	// update scope when set to primary
+	if d.Get(authmethodLdapIsPrimaryAuthMethodForScope).(bool) {
+		if err := updateScopeWithPrimaryAuthMethodId(ctx, scopeId, raw["id"].(string), meta); err != nil {
+			// Keep the auth method in the state, it was created
+			d.SetId(raw["id"].(string))
+			return err
+		}
+		raw[authmethodLdapIsPrimaryAuthMethodForScope] = true
+	}
+
+	if err := setFromLdapAuthMethodResponseMap(d, raw, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAuthMethodLdapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	raw, err := md.readAuthMethod(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading auth method: %v", err)
+	}
+	if raw == nil {
+		return diag.Errorf("auth method nil after read")
+	}
+
+	serr, isPrimary := readScopeIsPrimaryAuthMethodId(ctx, raw["scope_id"].(string), raw["id"].(string), meta)
+	if serr != nil {
+		return serr
+	}
+	raw[authmethodLdapIsPrimaryAuthMethodForScope] = isPrimary
+
+	if err := setFromLdapAuthMethodResponseMap(d, raw, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAuthMethodLdapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	amClient := authmethods.NewClient(md.client)
+
+	opts := []authmethods.Option{}
+
+	if d.HasChange(NameKey) {
+		opts = append(opts, authmethods.DefaultName())
+		if v, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, authmethods.WithName(v.(string)))
+		}
+	}
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, authmethods.DefaultDescription())
+		if v, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, authmethods.WithDescription(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapUrlsKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodUrls(expandStringList(d.Get(authmethodLdapUrlsKey).([]interface{}))))
+	}
+	if d.HasChange(authmethodLdapStateKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodState(d.Get(authmethodLdapStateKey).(string)))
+	}
+	if d.HasChange(authmethodLdapStartTlsKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodStartTls(d.Get(authmethodLdapStartTlsKey).(bool)))
+	}
+	if d.HasChange(authmethodLdapInsecureTlsKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodInsecureTls(d.Get(authmethodLdapInsecureTlsKey).(bool)))
+	}
+	if d.HasChange(authmethodLdapDiscoverDnKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodDiscoverDn(d.Get(authmethodLdapDiscoverDnKey).(bool)))
+	}
+	if d.HasChange(authmethodLdapAnonGroupSearchKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodAnonGroupSearch(d.Get(authmethodLdapAnonGroupSearchKey).(bool)))
+	}
+	if d.HasChange(authmethodLdapEnableGroupsKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodEnableGroups(d.Get(authmethodLdapEnableGroupsKey).(bool)))
+	}
+	if d.HasChange(authmethodLdapUseTokenGroupsKey) {
+		opts = append(opts, authmethods.WithLdapAuthMethodUseTokenGroups(d.Get(authmethodLdapUseTokenGroupsKey).(bool)))
+	}
+	if d.HasChange(authmethodLdapUpnDomainKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodUpnDomain())
+		if v, ok := d.GetOk(authmethodLdapUpnDomainKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodUpnDomain(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapUserDnKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodUserDn())
+		if v, ok := d.GetOk(authmethodLdapUserDnKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodUserDn(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapUserAttrKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodUserAttr())
+		if v, ok := d.GetOk(authmethodLdapUserAttrKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodUserAttr(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapUserFilterKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodUserFilter())
+		if v, ok := d.GetOk(authmethodLdapUserFilterKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodUserFilter(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapGroupDnKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodGroupDn())
+		if v, ok := d.GetOk(authmethodLdapGroupDnKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodGroupDn(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapGroupAttrKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodGroupAttr())
+		if v, ok := d.GetOk(authmethodLdapGroupAttrKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodGroupAttr(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapGroupFilterKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodGroupFilter())
+		if v, ok := d.GetOk(authmethodLdapGroupFilterKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodGroupFilter(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapCertificatesKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodCertificates())
+		if v, ok := d.GetOk(authmethodLdapCertificatesKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodCertificates(expandStringList(v.([]interface{}))))
+		}
+	}
+	if d.HasChange(authmethodLdapClientCertificateKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodClientCertificate())
+		if v, ok := d.GetOk(authmethodLdapClientCertificateKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodClientCertificate(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapClientCertificateKeyKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodClientCertificateKey())
+		if v, ok := d.GetOk(authmethodLdapClientCertificateKeyKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodClientCertificateKey(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapBindDnKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodBindDn())
+		if v, ok := d.GetOk(authmethodLdapBindDnKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodBindDn(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapBindPasswordKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodBindPassword())
+		if v, ok := d.GetOk(authmethodLdapBindPasswordKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodBindPassword(v.(string)))
+		}
+	}
+	if d.HasChange(authmethodLdapAccountAttributeMapsKey) {
+		opts = append(opts, authmethods.DefaultLdapAuthMethodAccountAttributeMaps())
+		if v, ok := d.GetOk(authmethodLdapAccountAttributeMapsKey); ok {
+			opts = append(opts, authmethods.WithLdapAuthMethodAccountAttributeMaps(expandStringList(v.([]interface{}))))
+		}
+	}
+
+	var raw map[string]interface{}
+	if len(opts) > 0 {
+		opts = append(opts, authmethods.WithAutomaticVersioning(true))
+		amur, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		md.cache.invalidate(d.Id())
+		if err != nil {
+			return diag.Errorf("error updating auth method: %v", err)
+		}
+		if amur == nil {
+			return diag.Errorf("auth method nil after update")
+		}
+		raw = amur.GetResponse().Map
+	}
+
+	if d.HasChange(authmethodLdapIsPrimaryAuthMethodForScope) && d.Get(authmethodLdapIsPrimaryAuthMethodForScope).(bool) {
+		if err := updateScopeWithPrimaryAuthMethodId(ctx, d.Get(ScopeIdKey).(string), d.Id(), meta); err != nil {
+			return err
+		}
+	}
+
+	if raw != nil {
+		raw[authmethodLdapIsPrimaryAuthMethodForScope] = d.Get(authmethodLdapIsPrimaryAuthMethodForScope).(bool)
+		if err := setFromLdapAuthMethodResponseMap(d, raw, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAuthMethodLdapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	amClient := authmethods.NewClient(md.client)
+
+	_, err := amClient.Delete(ctx, d.Id())
+	md.cache.invalidate(d.Id())
+	// The scope drops the auth method if it was its primary one
+	md.cache.invalidate(d.Get(ScopeIdKey).(string))
+	if err != nil {
+		return diag.Errorf("error deleting auth method: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fooAuthMethodLdapDesc       = "test auth method ldap"
+	fooAuthMethodLdapDescUpdate = "test auth method ldap update"
+)
+
+var (
+	fooAuthMethodLdap = fmt.Sprintf(`
+resource "boundary_auth_method_ldap" "foo" {
+	name          = "test"
+	description   = "%s"
+	scope_id      = boundary_scope.org1.id
+	depends_on    = [boundary_role.org1_admin]
+
+	urls          = ["ldaps://ldap1.example.com"]
+	user_dn       = "ou=Users,dc=example,dc=com"
+	user_attr     = "uid"
+	group_dn      = "ou=Groups,dc=example,dc=com"
+	enable_groups = true
+	bind_dn       = "cn=boundary,ou=Users,dc=example,dc=com"
+	bind_password = "bind_password"
+	certificates  = [
+<<EOT
+%s
+EOT
+	]
+	account_attribute_maps = ["sn=fullName"]
+}`, fooAuthMethodLdapDesc, fooAuthMethodOidcCaCerts)
+
+	fooAuthMethodLdapUpdate = fmt.Sprintf(`
+resource "boundary_auth_method_ldap" "foo" {
+	name                 = "test"
+	description          = "%s"
+	scope_id             = boundary_scope.org1.id
+	is_primary_for_scope = true
+	state                = "active-private"
+	depends_on           = [boundary_role.org1_admin]
+
+	urls          = ["ldaps://ldap1.example.com", "ldaps://ldap2.example.com"]
+	start_tls     = true
+	user_dn       = "ou=People,dc=example,dc=com"
+	user_attr     = "uid"
+	user_filter   = "({{.UserAttr}}={{.Username}})"
+	group_dn      = "ou=Groups,dc=example,dc=com"
+	group_attr    = "cn"
+	enable_groups = true
+	bind_dn       = "cn=boundary,ou=Users,dc=example,dc=com"
+	bind_password = "bind_password_update"
+}`, fooAuthMethodLdapDescUpdate)
+)
+
+func TestAccAuthMethodLdap(t *testing.T) {
+	skipForTestControllerVersion(t, "0.13.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckAuthMethodLdapResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, fooAuthMethodLdap),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "description", fooAuthMethodLdapDesc),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "name", "test"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "type", "ldap"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "state", "active-public"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "urls.#", "1"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "urls.0", "ldaps://ldap1.example.com"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", authmethodLdapUserDnKey, "ou=Users,dc=example,dc=com"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", authmethodLdapEnableGroupsKey, "true"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", authmethodLdapStartTlsKey, "false"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "account_attribute_maps.0", "sn=fullName"),
+					resource.TestCheckResourceAttrSet("boundary_auth_method_ldap.foo", authmethodLdapBindPasswordHmacKey),
+					testAccCheckAuthMethodLdapResourceExists(provider, "boundary_auth_method_ldap.foo"),
+					testAccIsPrimaryForScope(provider, "boundary_auth_method_ldap.foo", false),
+				),
+			},
+			importStep("boundary_auth_method_ldap.foo", "bind_password"),
+			{
+				// update
+				Config: testConfig(url, fooOrg, fooAuthMethodLdapUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "description", fooAuthMethodLdapDescUpdate),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "state", "active-private"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "urls.#", "2"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", authmethodLdapStartTlsKey, "true"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", authmethodLdapUserDnKey, "ou=People,dc=example,dc=com"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", authmethodLdapGroupAttrKey, "cn"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "certificates.#", "0"),
+					resource.TestCheckResourceAttr("boundary_auth_method_ldap.foo", "account_attribute_maps.#", "0"),
+					testAccCheckAuthMethodLdapResourceExists(provider, "boundary_auth_method_ldap.foo"),
+					testAccIsPrimaryForScope(provider, "boundary_auth_method_ldap.foo", true),
+				),
+			},
+			importStep("boundary_auth_method_ldap.foo", "bind_password"),
+		},
+	})
+}
+
+func testAccCheckAuthMethodLdapResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		amClient := authmethods.NewClient(md.client)
+
+		if _, err := amClient.Read(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading auth method %q: %w", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAuthMethodLdapResourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+
+		for _, rs := range s.RootModule().Resources {
+			switch rs.Type {
+			case "boundary_auth_method_ldap":
+				id := rs.Primary.ID
+
+				amClient := authmethods.NewClient(md.client)
+
+				_, err := amClient.Read(context.Background(), id)
+				if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("didn't get a 404 when reading destroyed auth method %q: %v", id, err)
+				}
+
+			default:
+				continue
+			}
+		}
+		return nil
+	}
+}
+
+func TestSetFromLdapAuthMethodResponseMap(t *testing.T) {
+	raw := map[string]interface{}{
+		"id":       "amldap_1234567890",
+		"scope_id": "global",
+		"type":     "ldap",
+		"attributes": map[string]interface{}{
+			"urls":               []interface{}{"ldaps://ldap.example.com"},
+			"start_tls":          true,
+			"user_dn":            "ou=people,dc=example,dc=com",
+			"state":              "active-public",
+			"bind_password_hmac": "new_hmac",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		stateHmac    string
+		fromRead     bool
+		wantPassword string
+	}{
+		{name: "create", fromRead: false, wantPassword: "secret"},
+		{name: "import", fromRead: true, wantPassword: "secret"},
+		{name: "unchanged", stateHmac: "new_hmac", fromRead: true, wantPassword: "secret"},
+		{name: "changed-in-boundary", stateHmac: "old_hmac", fromRead: true, wantPassword: authmethodLdapChangedInBoundaryPlaceholder},
+		{name: "changed-by-update", stateHmac: "old_hmac", fromRead: false, wantPassword: "secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceAuthMethodLdap().Schema, map[string]interface{}{
+				authmethodLdapBindPasswordKey: "secret",
+				authmethodLdapInsecureTlsKey:  true,
+			})
+			require.NoError(t, d.Set(authmethodLdapBindPasswordHmacKey, tt.stateHmac))
+
+			require.NoError(t, setFromLdapAuthMethodResponseMap(d, raw, tt.fromRead))
+			assert.Equal(t, "amldap_1234567890", d.Id())
+			assert.Equal(t, tt.wantPassword, d.Get(authmethodLdapBindPasswordKey))
+			assert.Equal(t, "new_hmac", d.Get(authmethodLdapBindPasswordHmacKey))
+			assert.Equal(t, []interface{}{"ldaps://ldap.example.com"}, d.Get(authmethodLdapUrlsKey))
+			assert.Equal(t, "ou=people,dc=example,dc=com", d.Get(authmethodLdapUserDnKey))
+			assert.Equal(t, true, d.Get(authmethodLdapStartTlsKey))
+			// The controller leaves out false values
+			assert.Equal(t, false, d.Get(authmethodLdapInsecureTlsKey))
+		})
+	}
+}
@@ -6,13 +6,18 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -29,6 +34,13 @@ const (
 	authmethodOidcIsPrimaryAuthMethodForScope          = "is_primary_for_scope"
 	authmethodOidcAccountClaimMapsKey                  = "account_claim_maps"
 	authmethodOidcClaimsScopesKey                      = "claims_scopes"
+	authmethodOidcPromptsKey                           = "prompts"
+
+	authmethodOidcPromptNone = "none"
+
+	authmethodOidcStateInactive      = "inactive"
+	authmethodOidcStateActivePrivate = "active-private"
+	authmethodOidcStateActivePublic  = "active-public"
 
 	// computed-only parameters
 	authmethodOidcCallbackUrlKey      = "callback_url"
@@ -47,6 +59,19 @@ func resourceAuthMethodOidc() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			requireControllerVersion("0.15.0", `The "prompts" of OIDC auth methods`, func(d *schema.ResourceDiff) bool {
+				return d.NewValueKnown(authmethodOidcPromptsKey) && d.Get(authmethodOidcPromptsKey).(*schema.Set).Len() > 0
+			}),
+			customizeDiffAuthMethodOidcPrompts,
+			checkPermissions(permissionCheck{
+				collection:       "auth-methods",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -65,9 +90,10 @@ func resourceAuthMethodOidc() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
+				Description: "The scope ID." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 
@@ -128,20 +154,31 @@ func resourceAuthMethodOidc() *schema.Resource {
 				Optional: true,
 			},
 			authmethodOidcAccountClaimMapsKey: {
-				Description: "Account claim maps for the to_claim of sub.",
+				Description: "Maps claims of the IdP to the fields of Boundary accounts, in the form `from_claim=to_claim`, e.g. `oid=sub`. `to_claim` must be one of `sub`, `name` or `email`. Changing the maps recreates the auth method.",
 				Type:        schema.TypeList,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateOidcAccountClaimMap,
 				},
 				Optional: true,
 				// per comment in https://github.com/hashicorp/boundary/pull/1186
 				ForceNew: true,
 			},
 			authmethodOidcClaimsScopesKey: {
-				Description: "Claims scopes.",
+				Description: "Additional scopes requested from the IdP, e.g. `profile` or `groups` to receive the claims managed group filters rely on. The `openid` scope is always requested and can't be set.",
 				Type:        schema.TypeList,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringNotInSlice([]string{"openid"}, false),
+				},
+				Optional: true,
+			},
+			authmethodOidcPromptsKey: {
+				Description: "The prompts the IdP is asked to show to the user when authenticating, any of `none`, `login`, `consent` and `select_account`. `none` can't be combined with other prompts.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{authmethodOidcPromptNone, "login", "consent", "select_account"}, false),
 				},
 				Optional: true,
 			},
@@ -154,10 +191,16 @@ func resourceAuthMethodOidc() *schema.Resource {
 				Computed:    true,
 			},
 			authmethodOidcStateKey: {
-				Description: "Can be one of 'inactive', 'active-private', or 'active-public'. Currently automatically set to active-public.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description: "Can be one of 'inactive', 'active-private', or 'active-public'. Defaults to active-public. " +
+					"The auth method is moved to the new state with the change state API whenever the value changes.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					authmethodOidcStateInactive,
+					authmethodOidcStateActivePrivate,
+					authmethodOidcStateActivePublic,
+				}, false),
 			},
 			authmethodOidcCallbackUrlKey: {
 				Description: "The URL that should be provided to the IdP for callbacks.",
@@ -181,6 +224,36 @@ func resourceAuthMethodOidc() *schema.Resource {
 	}
 }
 
+// validateOidcAccountClaimMap checks that an account claim map has the
+// "from_claim=to_claim" form and maps to an account field the controller
+// knows about.
+func validateOidcAccountClaimMap(i interface{}, k string) ([]string, []error) {
+	v := i.(string)
+	from, to, ok := strings.Cut(v, "=")
+	if !ok || strings.TrimSpace(from) == "" {
+		return nil, []error{fmt.Errorf(`%q entries must have the form "from_claim=to_claim", got %q`, k, v)}
+	}
+	switch strings.TrimSpace(to) {
+	case "sub", "name", "email":
+	default:
+		return nil, []error{fmt.Errorf(`%q entries must map to "sub", "name" or "email", got %q`, k, v)}
+	}
+	return nil, nil
+}
+
+// customizeDiffAuthMethodOidcPrompts rejects the "none" prompt when it is
+// combined with other prompts, which the IdP would refuse at login time.
+func customizeDiffAuthMethodOidcPrompts(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(authmethodOidcPromptsKey) {
+		return nil
+	}
+	prompts := d.Get(authmethodOidcPromptsKey).(*schema.Set)
+	if prompts.Contains(authmethodOidcPromptNone) && prompts.Len() > 1 {
+		return errors.New(`the "none" prompt can't be combined with other prompts`)
+	}
+	return nil
+}
+
 func setFromOidcAuthMethodResponseMap(d *schema.ResourceData, raw map[string]interface{}) diag.Diagnostics {
 	d.Set(NameKey, raw[NameKey])
 	d.Set(DescriptionKey, raw[DescriptionKey])
@@ -234,9 +307,13 @@ func setFromOidcAuthMethodResponseMap(d *schema.ResourceData, raw map[string]int
 			d.Set(authmethodOidcAccountClaimMapsKey, p.([]interface{}))
 		}
 
-		if p, ok := attrs[authmethodOidcClaimsScopesKey]; ok {
-			d.Set(authmethodOidcClaimsScopesKey, p.([]interface{}))
-		}
+		// The controller leaves out the claims scopes when there are none
+		claimsScopes, _ := attrs[authmethodOidcClaimsScopesKey].([]interface{})
+		d.Set(authmethodOidcClaimsScopesKey, claimsScopes)
+
+		// The controller leaves out the prompts when there are none
+		prompts, _ := attrs[authmethodOidcPromptsKey].([]interface{})
+		d.Set(authmethodOidcPromptsKey, prompts)
 	}
 
 	d.SetId(raw["id"].(string))
@@ -315,6 +392,10 @@ func resourceAuthMethodOidcCreate(ctx context.Context, d *schema.ResourceData, m
 		opts = append(opts, authmethods.WithOidcAuthMethodClaimsScopes(cList))
 	}
 
+	if prompts, ok := d.GetOk(authmethodOidcPromptsKey); ok {
+		opts = append(opts, authmethods.WithOidcAuthMethodPrompts(expandStringList(prompts.(*schema.Set).List())))
+	}
+
 	nameVal, ok := d.GetOk(NameKey)
 	if ok {
 		nameStr := nameVal.(string)
@@ -349,12 +430,21 @@ func resourceAuthMethodOidcCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("%v", err)
 	}
 
-	amid := amcr.GetResponse().Map["id"].(string)
+	raw := amcr.GetResponse().Map
+	amid := raw["id"].(string)
 
-	// auto set to active-public state
-	_, err = amClient.ChangeState(ctx, amid, 0, "active-public", authmethods.WithAutomaticVersioning(true))
-	if err != nil {
-		return diag.Errorf("%v", err)
+	// new auth methods are inactive, move them to the configured state which
+	// defaults to active-public
+	state := authmethodOidcStateActivePublic
+	if v, ok := d.GetOk(authmethodOidcStateKey); ok {
+		state = v.(string)
+	}
+	if state != authmethodOidcStateInactive {
+		amsr, err := amClient.ChangeState(ctx, amid, 0, state, authmethods.WithAutomaticVersioning(true))
+		if err != nil {
+			return diag.Errorf("error changing auth method state: %v", err)
+		}
+		raw = amsr.GetResponse().Map
 	}
 
 	// update scope when set to primary
@@ -364,11 +454,11 @@ func resourceAuthMethodOidcCreate(ctx context.Context, d *schema.ResourceData, m
 				return diag.Errorf("%v", err)
 			}
 
-			amcr.GetResponse().Map[authmethodOidcIsPrimaryAuthMethodForScope] = true
+			raw[authmethodOidcIsPrimaryAuthMethodForScope] = true
 		}
 	}
 
-	return setFromOidcAuthMethodResponseMap(d, amcr.GetResponse().Map)
+	return setFromOidcAuthMethodResponseMap(d, raw)
 }
 
 func updateScopeWithPrimaryAuthMethodId(ctx context.Context, scopeId, authmethodId string, meta interface{}) diag.Diagnostics {
@@ -380,6 +470,7 @@ func updateScopeWithPrimaryAuthMethodId(ctx context.Context, scopeId, authmethod
 	opts = append(opts, scopes.WithPrimaryAuthMethodId(authmethodId))
 
 	_, err := scp.Update(ctx, scopeId, 0, opts...)
+	md.cache.invalidate(scopeId)
 	if err != nil {
 		return diag.Errorf("error updating scope: %v", err)
 	}
@@ -389,14 +480,13 @@ func updateScopeWithPrimaryAuthMethodId(ctx context.Context, scopeId, authmethod
 
 func readScopeIsPrimaryAuthMethodId(ctx context.Context, scopeId, authmethodId string, meta interface{}) (diag.Diagnostics, bool) {
 	md := meta.(*metaData)
-	scp := scopes.NewClient(md.client)
 
-	srr, err := scp.Read(ctx, scopeId)
+	raw, err := md.readScope(ctx, scopeId)
 	if err != nil {
 		return diag.Errorf("%s", err), false
 	}
 
-	if p, ok := srr.GetResponse().Map["primary_auth_method_id"]; ok {
+	if p, ok := raw["primary_auth_method_id"]; ok {
 		if p == authmethodId {
 			return nil, true
 		}
@@ -407,9 +497,8 @@ func readScopeIsPrimaryAuthMethodId(ctx context.Context, scopeId, authmethodId s
 
 func resourceAuthMethodOidcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
-	amClient := authmethods.NewClient(md.client)
 
-	amrr, err := amClient.Read(ctx, d.Id())
+	raw, err := md.readAuthMethod(ctx, d.Id())
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr.Response().StatusCode() == http.StatusNotFound {
 			d.SetId("")
@@ -417,20 +506,20 @@ func resourceAuthMethodOidcRead(ctx context.Context, d *schema.ResourceData, met
 		}
 		return diag.Errorf("error reading auth method: %v", err)
 	}
-	if amrr == nil {
+	if raw == nil {
 		return diag.Errorf("auth method nil after read")
 	}
 
-	serr, isPrimary := readScopeIsPrimaryAuthMethodId(ctx, amrr.GetResponse().Map["scope_id"].(string), amrr.GetResponse().Map["id"].(string), meta)
+	serr, isPrimary := readScopeIsPrimaryAuthMethodId(ctx, raw["scope_id"].(string), raw["id"].(string), meta)
 	if err != nil {
 		return diag.Errorf("%v", serr)
 	}
 
 	if isPrimary {
-		amrr.GetResponse().Map[authmethodOidcIsPrimaryAuthMethodForScope] = true
+		raw[authmethodOidcIsPrimaryAuthMethodForScope] = true
 	}
 
-	return setFromOidcAuthMethodResponseMap(d, amrr.GetResponse().Map)
+	return setFromOidcAuthMethodResponseMap(d, raw)
 }
 
 func resourceAuthMethodOidcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -518,6 +607,7 @@ func resourceAuthMethodOidcUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if d.HasChange(authmethodOidcClaimsScopesKey) {
+		opts = append(opts, authmethods.DefaultOidcAuthMethodClaimsScopes())
 		if val, ok := d.GetOk(authmethodOidcClaimsScopesKey); ok {
 			claimsScopes := []string{}
 			for _, c := range val.([]interface{}) {
@@ -526,10 +616,17 @@ func resourceAuthMethodOidcUpdate(ctx context.Context, d *schema.ResourceData, m
 			opts = append(opts, authmethods.WithOidcAuthMethodClaimsScopes(claimsScopes))
 		}
 	}
+	if d.HasChange(authmethodOidcPromptsKey) {
+		opts = append(opts, authmethods.DefaultOidcAuthMethodPrompts())
+		if val, ok := d.GetOk(authmethodOidcPromptsKey); ok {
+			opts = append(opts, authmethods.WithOidcAuthMethodPrompts(expandStringList(val.(*schema.Set).List())))
+		}
+	}
 
 	if len(opts) > 0 {
 		opts = append(opts, authmethods.WithAutomaticVersioning(true))
 		amur, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		md.cache.invalidate(d.Id())
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
@@ -550,7 +647,21 @@ func resourceAuthMethodOidcUpdate(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 
-		return setFromOidcAuthMethodResponseMap(d, amur.GetResponse().Map)
+		if diags := setFromOidcAuthMethodResponseMap(d, amur.GetResponse().Map); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange(authmethodOidcStateKey) {
+		if state, ok := d.GetOk(authmethodOidcStateKey); ok {
+			amsr, err := amClient.ChangeState(ctx, d.Id(), 0, state.(string), authmethods.WithAutomaticVersioning(true))
+			md.cache.invalidate(d.Id())
+			if err != nil {
+				return diag.Errorf("error changing auth method state: %v", err)
+			}
+
+			return setFromOidcAuthMethodResponseMap(d, amsr.GetResponse().Map)
+		}
 	}
 	return nil
 }
@@ -560,6 +671,9 @@ func resourceAuthMethodOidcDelete(ctx context.Context, d *schema.ResourceData, m
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
+	md.cache.invalidate(d.Id())
+	// The scope drops the auth method if it was its primary one
+	md.cache.invalidate(d.Get(ScopeIdKey).(string))
 	if err != nil {
 		return diag.Errorf("error deleting auth method: %v", err)
 	}
@@ -18,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -92,11 +94,31 @@ EOT
   allowed_audiences = ["foo_aud_update"]
   signing_algorithms = ["ES256"]
   account_claim_maps = ["oid=sub"]
-	claims_scopes = ["profile"]
+	claims_scopes = ["profile", "groups"]
+	state = "active-private"
 
   // we need to disable this validatin, since the updated issuer isn't discoverable
   disable_discovered_config_validation = true 
 }`
+
+	fooAuthMethodOidcPrompts = `
+resource "boundary_auth_method_oidc" "foo" {
+	name        = "test"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+
+  issuer            = "%s"
+  client_id         = "foo_id"
+  client_secret     = "foo_secret"
+  api_url_prefix    = "http://localhost:9200"
+  idp_ca_certs   = [
+<<EOT
+%s
+EOT
+  ]
+	signing_algorithms = ["ES256"]
+	prompts = [%s]
+}`
 )
 
 func TestAccAuthMethodOidc(t *testing.T) {
@@ -128,6 +150,7 @@ func TestAccAuthMethodOidc(t *testing.T) {
 					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcAccountClaimMapsKey, []string{"oid=sub"}),
 					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcClaimsScopesKey, []string{"profile"}),
 					resource.TestCheckResourceAttr("boundary_auth_method_oidc.foo", authmethodOidcMaxAgeKey, "10"),
+					resource.TestCheckResourceAttr("boundary_auth_method_oidc.foo", authmethodOidcStateKey, authmethodOidcStateActivePublic),
 					testAccCheckAuthMethodOidcResourceExists(provider, "boundary_auth_method_oidc.foo"),
 					testAccIsPrimaryForScope(provider, "boundary_auth_method_oidc.foo", false),
 				),
@@ -142,8 +165,10 @@ func TestAccAuthMethodOidc(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_auth_method_oidc.foo", authmethodOidcIssuerKey, "https://test-update.com"),
 					resource.TestCheckResourceAttr("boundary_auth_method_oidc.foo", authmethodOidcClientIdKey, "foo_id_update"),
 					resource.TestCheckResourceAttr("boundary_auth_method_oidc.foo", authmethodOidcMaxAgeKey, "1"),
+					resource.TestCheckResourceAttr("boundary_auth_method_oidc.foo", authmethodOidcStateKey, authmethodOidcStateActivePrivate),
 					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcIdpCaCertsKey, []string{fooAuthMethodOidcCaCerts}),
 					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcAllowedAudiencesKey, []string{"foo_aud_update"}),
+					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcClaimsScopesKey, []string{"profile", "groups"}),
 					testAccCheckAuthMethodOidcResourceExists(provider, "boundary_auth_method_oidc.foo"),
 					testAccIsPrimaryForScope(provider, "boundary_auth_method_oidc.foo", true),
 					testAccCheckAuthMethodOidcResourceExists(provider, "boundary_auth_method_oidc.foo"),
@@ -154,6 +179,148 @@ func TestAccAuthMethodOidc(t *testing.T) {
 	})
 }
 
+func TestAccAuthMethodOidcPrompts(t *testing.T) {
+	skipForTestControllerVersion(t, "0.15.0")
+
+	tp := oidc.StartTestProvider(t)
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	tpCert := strings.TrimSpace(tp.CACert())
+	createConfig := fmt.Sprintf(fooAuthMethodOidcPrompts, tp.Addr(), tpCert, `"consent", "select_account"`)
+	updateConfig := fmt.Sprintf(fooAuthMethodOidcPrompts, tp.Addr(), tpCert, `"none"`)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckAuthMethodOidcResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, createConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthMethodOidcResourceExists(provider, "boundary_auth_method_oidc.foo"),
+					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcPromptsKey, []string{"consent", "select_account"}),
+				),
+			},
+			importStep("boundary_auth_method_oidc.foo", "client_secret", "is_primary_for_scope"),
+			{
+				// update
+				Config: testConfig(url, fooOrg, updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthMethodOidcResourceExists(provider, "boundary_auth_method_oidc.foo"),
+					testAccCheckAuthMethodOidcAttrAryValueSet(provider, "boundary_auth_method_oidc.foo", authmethodOidcPromptsKey, []string{"none"}),
+				),
+			},
+			importStep("boundary_auth_method_oidc.foo", "client_secret", "is_primary_for_scope"),
+		},
+	})
+}
+
+func TestCustomizeDiffAuthMethodOidcPrompts(t *testing.T) {
+	tests := []struct {
+		name    string
+		prompts []interface{}
+		wantErr string
+	}{
+		{name: "none", prompts: []interface{}{"none"}},
+		{name: "consent-and-select-account", prompts: []interface{}{"consent", "select_account"}},
+		{name: "none-and-login", prompts: []interface{}{"none", "login"}, wantErr: `the "none" prompt can't be combined with other prompts`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				ScopeIdKey:               "global",
+				authmethodOidcPromptsKey: tt.prompts,
+			})
+			_, err := resourceAuthMethodOidc().Diff(context.Background(), nil, config, &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceAuthMethodOidcCreatePrompts(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/auth-methods", &body, map[string]interface{}{
+		"id":       "amoidc_1234567890",
+		"scope_id": "global",
+		"type":     "oidc",
+		"version":  1,
+		"attributes": map[string]interface{}{
+			"state":              authmethodOidcStateInactive,
+			"issuer":             "https://test.example.com",
+			"client_id":          "foo_id",
+			"client_secret_hmac": "hmac",
+			"prompts":            []string{"consent", "select_account"},
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceAuthMethodOidc().Schema, map[string]interface{}{
+		ScopeIdKey:                    "global",
+		authmethodOidcStateKey:        authmethodOidcStateInactive,
+		authmethodOidcIssuerKey:       "https://test.example.com",
+		authmethodOidcClientIdKey:     "foo_id",
+		authmethodOidcClientSecretKey: "foo_secret",
+		authmethodOidcPromptsKey:      []interface{}{"consent", "select_account"},
+	})
+	require.False(t, resourceAuthMethodOidcCreate(context.Background(), d, md).HasError())
+
+	attrs, ok := body["attributes"].(map[string]interface{})
+	require.True(t, ok)
+	assert.ElementsMatch(t, []interface{}{"consent", "select_account"}, attrs["prompts"])
+	assert.Equal(t, "amoidc_1234567890", d.Id())
+	assert.ElementsMatch(t, []interface{}{"consent", "select_account"}, d.Get(authmethodOidcPromptsKey).(*schema.Set).List())
+}
+
+func TestSetFromOidcAuthMethodResponseMapPrompts(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAuthMethodOidc().Schema, map[string]interface{}{
+		authmethodOidcPromptsKey: []interface{}{"login"},
+	})
+	// The controller leaves out the prompts once they are removed
+	require.False(t, setFromOidcAuthMethodResponseMap(d, map[string]interface{}{
+		"id":       "amoidc_1234567890",
+		"scope_id": "global",
+		"type":     "oidc",
+		"attributes": map[string]interface{}{
+			"state":              authmethodOidcStateActivePublic,
+			"issuer":             "https://test.example.com",
+			"client_id":          "foo_id",
+			"client_secret_hmac": "hmac",
+		},
+	}).HasError())
+	assert.Equal(t, 0, d.Get(authmethodOidcPromptsKey).(*schema.Set).Len())
+}
+
+func TestValidateOidcAccountClaimMap(t *testing.T) {
+	tests := []struct {
+		claimMap string
+		wantErr  string
+	}{
+		{claimMap: "oid=sub"},
+		{claimMap: "display_name=name"},
+		{claimMap: "upn=email"},
+		{claimMap: "oid", wantErr: `must have the form "from_claim=to_claim"`},
+		{claimMap: "=sub", wantErr: `must have the form "from_claim=to_claim"`},
+		{claimMap: "groups=groups", wantErr: `must map to "sub", "name" or "email"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.claimMap, func(t *testing.T) {
+			_, errs := validateOidcAccountClaimMap(tt.claimMap, authmethodOidcAccountClaimMapsKey)
+			if tt.wantErr != "" {
+				require.Len(t, errs, 1)
+				assert.ErrorContains(t, errs[0], tt.wantErr)
+				return
+			}
+			assert.Empty(t, errs)
+		})
+	}
+}
+
 func testAccCheckAuthMethodOidcResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -31,6 +33,15 @@ func resourceAuthMethodPassword() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			checkPermissions(permissionCheck{
+				collection:       "auth-methods",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -49,9 +60,10 @@ func resourceAuthMethodPassword() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
+				Description: "The scope ID." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			TypeKey: {
@@ -61,16 +73,18 @@ func resourceAuthMethodPassword() *schema.Resource {
 				Optional:    true,
 			},
 			authmethodMinLoginNameLengthKey: {
-				Description: "The minimum login name length.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
+				Description:  "The minimum length of the login names of the accounts of the auth method. Defaults to the controller's default of 3.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			authmethodMinPasswordLengthKey: {
-				Description: "The minimum password length.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
+				Description:  "The minimum length of the passwords of the accounts of the auth method. Defaults to the controller's default of 8.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 		},
 	}
@@ -85,13 +99,15 @@ func setFromPasswordAuthMethodResponseMap(d *schema.ResourceData, raw map[string
 	if attrsVal, ok := raw["attributes"]; ok {
 		attrs := attrsVal.(map[string]interface{})
 
-		minLoginNameLength := attrs[authmethodMinLoginNameLengthKey].(json.Number)
-		minLoginNameLengthInt, _ := minLoginNameLength.Int64()
-		d.Set(authmethodMinLoginNameLengthKey, int(minLoginNameLengthInt))
+		if minLoginNameLength, ok := attrs[authmethodMinLoginNameLengthKey].(json.Number); ok {
+			minLoginNameLengthInt, _ := minLoginNameLength.Int64()
+			d.Set(authmethodMinLoginNameLengthKey, int(minLoginNameLengthInt))
+		}
 
-		minPasswordLength := attrs[authmethodMinPasswordLengthKey].(json.Number)
-		minPasswordLengthInt, _ := minPasswordLength.Int64()
-		d.Set(authmethodMinPasswordLengthKey, int(minPasswordLengthInt))
+		if minPasswordLength, ok := attrs[authmethodMinPasswordLengthKey].(json.Number); ok {
+			minPasswordLengthInt, _ := minPasswordLength.Int64()
+			d.Set(authmethodMinPasswordLengthKey, int(minPasswordLengthInt))
+		}
 	}
 
 	d.SetId(raw["id"].(string))
@@ -163,9 +179,8 @@ func resourceAuthMethodPasswordCreate(ctx context.Context, d *schema.ResourceDat
 
 func resourceAuthMethodPasswordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
-	amClient := authmethods.NewClient(md.client)
 
-	amrr, err := amClient.Read(ctx, d.Id())
+	raw, err := md.readAuthMethod(ctx, d.Id())
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr.Response().StatusCode() == http.StatusNotFound {
 			d.SetId("")
@@ -173,11 +188,11 @@ func resourceAuthMethodPasswordRead(ctx context.Context, d *schema.ResourceData,
 		}
 		return diag.Errorf("error reading auth method: %v", err)
 	}
-	if amrr == nil {
+	if raw == nil {
 		return diag.Errorf("auth method nil after read")
 	}
 
-	return setFromPasswordAuthMethodResponseMap(d, amrr.GetResponse().Map)
+	return setFromPasswordAuthMethodResponseMap(d, raw)
 }
 
 func resourceAuthMethodPasswordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -221,6 +236,7 @@ func resourceAuthMethodPasswordUpdate(ctx context.Context, d *schema.ResourceDat
 	if len(opts) > 0 {
 		opts = append(opts, authmethods.WithAutomaticVersioning(true))
 		amur, err := amClient.Update(ctx, d.Id(), 0, opts...)
+		md.cache.invalidate(d.Id())
 		if err != nil {
 			return diag.Errorf("error updating auth method: %v", err)
 		}
@@ -235,6 +251,9 @@ func resourceAuthMethodPasswordDelete(ctx context.Context, d *schema.ResourceDat
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
+	md.cache.invalidate(d.Id())
+	// The scope drops the auth method if it was its primary one
+	md.cache.invalidate(d.Get(ScopeIdKey).(string))
 	if err != nil {
 		return diag.Errorf("error deleting auth method: %v", err)
 	}
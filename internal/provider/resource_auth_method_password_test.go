@@ -39,6 +39,9 @@ resource "boundary_auth_method_password" "foo" {
 	type        = "password"
 	scope_id    = boundary_scope.org1.id
 	depends_on  = [boundary_role.org1_admin]
+
+	min_login_name_length = 5
+	min_password_length   = 12
 }`, fooAuthMethodDescUpdate)
 )
 
@@ -59,6 +62,8 @@ func TestAccAuthMethodPassword(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", "description", fooAuthMethodDesc),
 					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", "name", "test"),
 					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", "type", "password"),
+					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", authmethodMinLoginNameLengthKey, "3"),
+					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", authmethodMinPasswordLengthKey, "8"),
 					testAccCheckAuthMethodResourceExists(provider, "boundary_auth_method_password.foo"),
 				),
 			},
@@ -70,6 +75,8 @@ func TestAccAuthMethodPassword(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", "description", fooAuthMethodDescUpdate),
 					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", "name", "test"),
 					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", "type", "password"),
+					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", authmethodMinLoginNameLengthKey, "5"),
+					resource.TestCheckResourceAttr("boundary_auth_method_password.foo", authmethodMinPasswordLengthKey, "12"),
 					testAccCheckAuthMethodResourceExists(provider, "boundary_auth_method_password.foo"),
 				),
 			},
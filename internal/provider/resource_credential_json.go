@@ -6,12 +6,15 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentials"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
 
 const (
@@ -31,6 +34,15 @@ func resourceCredentialJson() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			requireControllerVersion("0.11.0", "boundary_credential_json", nil),
+			checkPermissions(permissionCheck{
+				collection:       "credentials",
+				parentKey:        credentialStoreIdKey,
+				parentCollection: "credential-stores",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -55,10 +67,13 @@ func resourceCredentialJson() *schema.Resource {
 				Required:    true,
 			},
 			credentialJsonObjectKey: {
-				Description: `The object for the this json credential. Either values encoded with the "jsonencode" function, pre-escaped JSON string, or a file`,
+				Description: `The object for the this json credential. Either values encoded with the "jsonencode" function, pre-escaped JSON string, or a file. Differences in formatting or key order are ignored.`,
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				// Formatting and key order don't change the object
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ValidateFunc:     validateJsonObject,
 			},
 			credentialJsonObjectHmacKey: {
 				Description: "The object hmac.",
@@ -82,15 +97,17 @@ func setFromCredentialJsonResponseMap(d *schema.ResourceData, raw map[string]int
 
 	if attrsVal, ok := raw["attributes"]; ok {
 		attrs := attrsVal.(map[string]interface{})
-		stateObjectHmac := d.Get(credentialJsonObjectHmacKey)
-		boundaryObjectHmac := attrs[credentialJsonObjectHmacKey].(string)
-		if stateObjectHmac.(string) != boundaryObjectHmac && fromRead {
+		stateObjectHmac := d.Get(credentialJsonObjectHmacKey).(string)
+		boundaryObjectHmac, _ := attrs[credentialJsonObjectHmacKey].(string)
+		if stateObjectHmac != boundaryObjectHmac && fromRead {
+			// ObjectHmac has changed in Boundary, therefore the object has changed.
+			// Update object value to force tf to attempt update.
 			if err := d.Set(credentialJsonObjectKey, "(changed in Boundary)"); err != nil {
 				return err
 			}
 		}
 		if err := d.Set(credentialJsonObjectHmacKey, boundaryObjectHmac); err != nil {
-			return nil
+			return err
 		}
 	}
 
@@ -99,6 +116,20 @@ func setFromCredentialJsonResponseMap(d *schema.ResourceData, raw map[string]int
 	return nil
 }
 
+// validateJsonObject makes sure the value is a JSON object, which is the only
+// kind of JSON Boundary accepts for a json credential.
+func validateJsonObject(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	var jsonObject map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &jsonObject); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON object: %v", k, err)}
+	}
+	return nil, nil
+}
+
 func resourceCredentialJsonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
@@ -31,6 +32,14 @@ const (
 		password = "password",
 		username = "db-admin"
 	})`
+	// Same object as jsonCredObjUpdate, formatted differently
+	jsonCredObjUpdateReformatted = `<<EOT
+{
+  "username": "db-admin",
+  "password": "password"
+}
+EOT`
+	jsonCredObjNotAnObject = `jsonencode(["admin", "password"])`
 )
 
 func jsonCredResource(name, description, object string) string {
@@ -104,6 +113,17 @@ func TestAccCredentialJson(t *testing.T) {
 				Config:   testConfig(url, fooOrg, firstProjectFoo, resUpdate),
 			},
 			importStep(jsonCredResc, credentialJsonObjectKey),
+			{
+				// Reformatting the object must not cause an update
+				PlanOnly: true,
+				Config:   testConfig(url, fooOrg, firstProjectFoo, jsonCredResource(jsonCredNameUpdate, jsonCredDescUpdate, jsonCredObjUpdateReformatted)),
+			},
+			{
+				// Only JSON objects are accepted
+				PlanOnly:    true,
+				Config:      testConfig(url, fooOrg, firstProjectFoo, jsonCredResource(jsonCredNameUpdate, jsonCredDescUpdate, jsonCredObjNotAnObject)),
+				ExpectError: regexp.MustCompile("must be a JSON object"),
+			},
 			{
 				// update again but apply a preConfig to externally update resource
 				PreConfig: func() { jsonCredExternalUpdate(t, provider) },
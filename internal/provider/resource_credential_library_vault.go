@@ -5,15 +5,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
+	credentialLibraryVaultType = "vault"
+
 	credentialStoreIdKey                           = "credential_store_id"
 	credentialLibraryVaultHttpMethodKey            = "http_method"
 	credentialLibraryVaultHttpRequestBodyKey       = "http_request_body"
@@ -22,6 +28,13 @@ const (
 	credentialLibraryCredentialMappingOverridesKey = "credential_mapping_overrides"
 )
 
+// credentialMappingOverrideKeys are the mapping overrides Boundary accepts for
+// each credential type.
+var credentialMappingOverrideKeys = map[string][]string{
+	"username_password": {"username_attribute", "password_attribute"},
+	"ssh_private_key":   {"username_attribute", "private_key_attribute", "private_key_passphrase_attribute"},
+}
+
 var libraryVaultAttrs = []string{
 	credentialLibraryVaultHttpMethodKey,
 	credentialLibraryVaultHttpRequestBodyKey,
@@ -39,6 +52,15 @@ func resourceCredentialLibraryVault() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffCredentialMappingOverrides,
+			checkPermissions(permissionCheck{
+				collection:       "credential-libraries",
+				parentKey:        credentialStoreIdKey,
+				parentCollection: "credential-stores",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -78,14 +100,20 @@ func resourceCredentialLibraryVault() *schema.Resource {
 				Required:    true,
 			},
 			credentialLibraryCredentialTypeKey: {
-				Description: "The type of credential the library generates.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The type of credential the library generates, one of `username_password`, `ssh_private_key` or `json`. Typed credentials can be injected into sessions by targets. Cannot be changed once the library is created.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"username_password", "ssh_private_key", "json"}, false),
 			},
 			credentialLibraryCredentialMappingOverridesKey: {
-				Description: "The credential mapping override.",
-				Type:        schema.TypeMap,
-				Optional:    true,
+				Description: "The credential mapping overrides, to map the fields of a non-standard Vault secret to the credential. " +
+					"`username_attribute` and `password_attribute` can be set for `username_password` credentials, " +
+					"`username_attribute`, `private_key_attribute` and `private_key_passphrase_attribute` for `ssh_private_key` credentials. " +
+					"Requires `credential_type` to be set.",
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
@@ -102,6 +130,9 @@ func setFromVaultCredentialLibraryResponseMap(d *schema.ResourceData, raw map[st
 		return err
 	}
 
+	if err := d.Set(credentialLibraryCredentialTypeKey, raw[credentialLibraryCredentialTypeKey]); err != nil {
+		return err
+	}
 	if err := d.Set(credentialLibraryCredentialMappingOverridesKey, raw[credentialLibraryCredentialMappingOverridesKey]); err != nil {
 		return err
 	}
@@ -120,6 +151,38 @@ func setFromVaultCredentialLibraryResponseMap(d *schema.ResourceData, raw map[st
 	return nil
 }
 
+func customizeDiffCredentialMappingOverrides(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(credentialLibraryCredentialMappingOverridesKey) || !d.NewValueKnown(credentialLibraryCredentialTypeKey) {
+		return nil
+	}
+	overrides := d.Get(credentialLibraryCredentialMappingOverridesKey).(map[string]interface{})
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	credType := d.Get(credentialLibraryCredentialTypeKey).(string)
+	if credType == "" {
+		return fmt.Errorf("%q can only be used together with %q", credentialLibraryCredentialMappingOverridesKey, credentialLibraryCredentialTypeKey)
+	}
+	validKeys, ok := credentialMappingOverrideKeys[credType]
+	if !ok {
+		return fmt.Errorf("%q are not supported for credentials of type %q", credentialLibraryCredentialMappingOverridesKey, credType)
+	}
+	for k := range overrides {
+		valid := false
+		for _, validKey := range validKeys {
+			if k == validKey {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid credential mapping override %q for credentials of type %q, must be one of %s", k, credType, strings.Join(validKeys, ", "))
+		}
+	}
+	return nil
+}
+
 func resourceCredentialLibraryCreateVault(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 
@@ -156,7 +219,7 @@ func resourceCredentialLibraryCreateVault(ctx context.Context, d *schema.Resourc
 	}
 
 	client := credentiallibraries.NewClient(md.client)
-	cr, err := client.Create(ctx, credentialStoreId, opts...)
+	cr, err := client.Create(ctx, credentialLibraryVaultType, credentialStoreId, opts...)
 	if err != nil {
 		return diag.Errorf("error creating credential library: %v", err)
 	}
@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	credentialLibraryVaultSshCertificateType = "vault-ssh-certificate"
+
+	credentialLibraryVaultSshCertificateUsernameKey        = "username"
+	credentialLibraryVaultSshCertificateKeyTypeKey         = "key_type"
+	credentialLibraryVaultSshCertificateKeyBitsKey         = "key_bits"
+	credentialLibraryVaultSshCertificateTtlKey             = "ttl"
+	credentialLibraryVaultSshCertificateKeyIdKey           = "key_id"
+	credentialLibraryVaultSshCertificateCriticalOptionsKey = "critical_options"
+	credentialLibraryVaultSshCertificateExtensionsKey      = "extensions"
+)
+
+func resourceCredentialLibraryVaultSshCertificate() *schema.Resource {
+	return &schema.Resource{
+		Description: "The credential library for Vault SSH certificate resource allows you to configure a Boundary " +
+			"credential library that requests short-lived SSH certificates from the Vault SSH secrets engine, " +
+			"for use with SSH targets instead of static keys.",
+
+		CreateContext: resourceCredentialLibraryVaultSshCertificateCreate,
+		ReadContext:   resourceCredentialLibraryVaultSshCertificateRead,
+		UpdateContext: resourceCredentialLibraryVaultSshCertificateUpdate,
+		DeleteContext: resourceCredentialLibraryVaultSshCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			requireControllerVersion("0.12.0", "boundary_credential_library_vault_ssh_certificate", nil),
+			checkPermissions(permissionCheck{
+				collection:       "credential-libraries",
+				parentKey:        credentialStoreIdKey,
+				parentCollection: "credential-stores",
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the Vault SSH certificate credential library.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The Vault SSH certificate credential library name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The Vault SSH certificate credential library description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			credentialStoreIdKey: {
+				Description: "The ID of the credential store that this library belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			credentialLibraryVaultPathKey: {
+				Description: "The path in Vault to request the certificate from, e.g. `ssh/sign/<role>` or `ssh/issue/<role>`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			credentialLibraryVaultSshCertificateUsernameKey: {
+				Description: "The username to use with the certificate returned by the library.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			credentialLibraryVaultSshCertificateKeyTypeKey: {
+				Description:  "The type of key to use for the certificate, one of `ed25519`, `ecdsa` or `rsa`. Defaults to `ed25519`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ed25519", "ecdsa", "rsa"}, false),
+			},
+			credentialLibraryVaultSshCertificateKeyBitsKey: {
+				Description: "The number of bits of the key. Must be left unset for `ed25519` keys, defaults to 256 for `ecdsa` and 2048 for `rsa` keys.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			credentialLibraryVaultSshCertificateTtlKey: {
+				Description: "The requested time to live of the certificate, e.g. `1h`. Defaults to the TTL of the Vault role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			credentialLibraryVaultSshCertificateKeyIdKey: {
+				Description: "The key ID the certificate is issued with.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			credentialLibraryVaultSshCertificateCriticalOptionsKey: {
+				Description: "The critical options the certificate is issued with, `force-command` to run a single command " +
+					"and `source-address` to only accept connections from a comma separated list of addresses and CIDR blocks. " +
+					"Options of the form `name@domain` are passed on as they are.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateSshCertificateCriticalOptions,
+			},
+			credentialLibraryVaultSshCertificateExtensionsKey: {
+				Description: "The extensions the certificate is issued with, e.g. `permit-pty` or `permit-port-forwarding`, " +
+					"each with an empty value. Extensions of the form `name@domain` are passed on as they are.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateSshCertificateExtensions,
+			},
+		},
+	}
+}
+
+// validateSshCertificateCriticalOptions checks the critical options are known
+// to OpenSSH, which refuses certificates with critical options it doesn't
+// understand, and that their values are valid.
+func validateSshCertificateCriticalOptions(i interface{}, k string) ([]string, []error) {
+	var errs []error
+	for name, v := range i.(map[string]interface{}) {
+		value, _ := v.(string)
+		switch {
+		case name == "force-command":
+			if value == "" {
+				errs = append(errs, fmt.Errorf("%q option %q requires a command", k, name))
+			}
+		case name == "source-address":
+			for _, addr := range strings.Split(value, ",") {
+				addr = strings.TrimSpace(addr)
+				if _, _, err := net.ParseCIDR(addr); err != nil && net.ParseIP(addr) == nil {
+					errs = append(errs, fmt.Errorf("%q option %q contains an invalid address or CIDR block %q", k, name, addr))
+				}
+			}
+		case strings.Contains(name, "@"):
+		default:
+			errs = append(errs, fmt.Errorf(`%q contains the unknown option %q, expected "force-command", "source-address" or "name@domain"`, k, name))
+		}
+	}
+	return nil, errs
+}
+
+// validateSshCertificateExtensions checks the extensions are the ones defined
+// by OpenSSH, which all have an empty value, or vendor extensions.
+func validateSshCertificateExtensions(i interface{}, k string) ([]string, []error) {
+	var errs []error
+	for name, v := range i.(map[string]interface{}) {
+		switch name {
+		case "no-touch-required", "permit-X11-forwarding", "permit-agent-forwarding",
+			"permit-port-forwarding", "permit-pty", "permit-user-rc":
+			if value, _ := v.(string); value != "" {
+				errs = append(errs, fmt.Errorf("%q extension %q must have an empty value", k, name))
+			}
+		default:
+			if !strings.Contains(name, "@") {
+				errs = append(errs, fmt.Errorf(`%q contains the unknown extension %q, vendor extensions must be of the form "name@domain"`, k, name))
+			}
+		}
+	}
+	return nil, errs
+}
+
+func setFromVaultSshCertificateCredentialLibraryResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	if err := d.Set(NameKey, raw[NameKey]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw[DescriptionKey]); err != nil {
+		return err
+	}
+	if err := d.Set(credentialStoreIdKey, raw[credentialStoreIdKey]); err != nil {
+		return err
+	}
+
+	if attrsVal, ok := raw["attributes"]; ok {
+		attrs := attrsVal.(map[string]interface{})
+		for _, k := range []string{
+			credentialLibraryVaultPathKey,
+			credentialLibraryVaultSshCertificateUsernameKey,
+			credentialLibraryVaultSshCertificateKeyTypeKey,
+			credentialLibraryVaultSshCertificateTtlKey,
+			credentialLibraryVaultSshCertificateKeyIdKey,
+			credentialLibraryVaultSshCertificateCriticalOptionsKey,
+			credentialLibraryVaultSshCertificateExtensionsKey,
+		} {
+			if err := d.Set(k, attrs[k]); err != nil {
+				return err
+			}
+		}
+
+		var keyBits int64
+		if v, ok := attrs[credentialLibraryVaultSshCertificateKeyBitsKey].(json.Number); ok {
+			keyBits, _ = v.Int64()
+		}
+		if err := d.Set(credentialLibraryVaultSshCertificateKeyBitsKey, keyBits); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(raw["id"].(string))
+
+	return nil
+}
+
+// expandStringMap converts a TypeMap of strings to the form the API expects.
+func expandStringMap(raw map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(raw))
+	for k, v := range raw {
+		m[k] = v.(string)
+	}
+	return m
+}
+
+func resourceCredentialLibraryVaultSshCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	var opts []credentiallibraries.Option
+	if v, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, credentiallibraries.WithName(v.(string)))
+	}
+	if v, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, credentiallibraries.WithDescription(v.(string)))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultPathKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryPath(v.(string)))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateUsernameKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryUsername(v.(string)))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateKeyTypeKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryKeyType(v.(string)))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateKeyBitsKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryKeyBits(uint32(v.(int))))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateTtlKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryTtl(v.(string)))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateKeyIdKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryKeyId(v.(string)))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateCriticalOptionsKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryCriticalOptions(expandStringMap(v.(map[string]interface{}))))
+	}
+	if v, ok := d.GetOk(credentialLibraryVaultSshCertificateExtensionsKey); ok {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryExtensions(expandStringMap(v.(map[string]interface{}))))
+	}
+
+	var credentialStoreId string
+	cid, ok := d.GetOk(credentialStoreIdKey)
+	if ok {
+		credentialStoreId = cid.(string)
+	} else {
+		return diag.Errorf("no credential store ID is set")
+	}
+
+	client := credentiallibraries.NewClient(md.client)
+	cr, err := client.Create(ctx, credentialLibraryVaultSshCertificateType, credentialStoreId, opts...)
+	if err != nil {
+		return diag.Errorf("error creating credential library: %v", err)
+	}
+	if cr == nil {
+		return diag.Errorf("nil credential library after create")
+	}
+
+	if err := setFromVaultSshCertificateCredentialLibraryResponseMap(d, cr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCredentialLibraryVaultSshCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	client := credentiallibraries.NewClient(md.client)
+
+	cr, err := client.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading credential library: %v", err)
+	}
+	if cr == nil {
+		return diag.Errorf("credential library nil after read")
+	}
+
+	if err := setFromVaultSshCertificateCredentialLibraryResponseMap(d, cr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCredentialLibraryVaultSshCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	client := credentiallibraries.NewClient(md.client)
+
+	var opts []credentiallibraries.Option
+	if d.HasChange(NameKey) {
+		opts = append(opts, credentiallibraries.DefaultName())
+		if v, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, credentiallibraries.WithName(v.(string)))
+		}
+	}
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, credentiallibraries.DefaultDescription())
+		if v, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, credentiallibraries.WithDescription(v.(string)))
+		}
+	}
+	if d.HasChange(credentialLibraryVaultPathKey) {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryPath(d.Get(credentialLibraryVaultPathKey).(string)))
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateUsernameKey) {
+		opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryUsername(d.Get(credentialLibraryVaultSshCertificateUsernameKey).(string)))
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateKeyTypeKey) {
+		opts = append(opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryKeyType())
+		if v, ok := d.GetOk(credentialLibraryVaultSshCertificateKeyTypeKey); ok {
+			opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryKeyType(v.(string)))
+		}
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateKeyBitsKey) {
+		opts = append(opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryKeyBits())
+		if v, ok := d.GetOk(credentialLibraryVaultSshCertificateKeyBitsKey); ok {
+			opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryKeyBits(uint32(v.(int))))
+		}
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateTtlKey) {
+		opts = append(opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryTtl())
+		if v, ok := d.GetOk(credentialLibraryVaultSshCertificateTtlKey); ok {
+			opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryTtl(v.(string)))
+		}
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateKeyIdKey) {
+		opts = append(opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryKeyId())
+		if v, ok := d.GetOk(credentialLibraryVaultSshCertificateKeyIdKey); ok {
+			opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryKeyId(v.(string)))
+		}
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateCriticalOptionsKey) {
+		opts = append(opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryCriticalOptions())
+		if v, ok := d.GetOk(credentialLibraryVaultSshCertificateCriticalOptionsKey); ok {
+			opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryCriticalOptions(expandStringMap(v.(map[string]interface{}))))
+		}
+	}
+	if d.HasChange(credentialLibraryVaultSshCertificateExtensionsKey) {
+		opts = append(opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryExtensions())
+		if v, ok := d.GetOk(credentialLibraryVaultSshCertificateExtensionsKey); ok {
+			opts = append(opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryExtensions(expandStringMap(v.(map[string]interface{}))))
+		}
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, credentiallibraries.WithAutomaticVersioning(true))
+		cur, err := client.Update(ctx, d.Id(), 0, opts...)
+		if err != nil {
+			return diag.Errorf("error updating credential library: %v", err)
+		}
+
+		if err := setFromVaultSshCertificateCredentialLibraryResponseMap(d, cur.GetResponse().Map); err != nil {
+			return diag.Errorf("error setting credential library from response: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCredentialLibraryVaultSshCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	client := credentiallibraries.NewClient(md.client)
+
+	_, err := client.Delete(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error deleting credential library: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	vaultSshCertCredResc        = "boundary_credential_library_vault_ssh_certificate.example"
+	vaultSshCertCredLibName     = "foo"
+	vaultSshCertCredLibDesc     = "the foo"
+	vaultSshCertCredLibPath     = "ssh/sign/foo"
+	vaultSshCertCredLibUsername = "admin"
+)
+
+var vaultSshCertCredLibResource = fmt.Sprintf(`
+resource "boundary_credential_library_vault_ssh_certificate" "example" {
+	name                = "%s"
+	description         = "%s"
+	credential_store_id = boundary_credential_store_vault.example.id
+	path                = "%s"
+	username            = "%s"
+}`, vaultSshCertCredLibName,
+	vaultSshCertCredLibDesc,
+	vaultSshCertCredLibPath,
+	vaultSshCertCredLibUsername)
+
+var vaultSshCertCredLibResourceUpdate = fmt.Sprintf(`
+resource "boundary_credential_library_vault_ssh_certificate" "example" {
+	name                = "%s"
+	description         = "%s"
+	credential_store_id = boundary_credential_store_vault.example.id
+	path                = "%s"
+	username            = "%s"
+	key_type            = "rsa"
+	key_bits            = 4096
+	ttl                 = "1h"
+	key_id              = "boundary"
+	critical_options = {
+		force-command  = "/bin/bash"
+		source-address = "10.0.0.0/8,192.168.1.1"
+	}
+	extensions = {
+		permit-pty = ""
+	}
+}`, vaultSshCertCredLibName+vaultCredLibStringUpdate,
+	vaultSshCertCredLibDesc+vaultCredLibStringUpdate,
+	vaultSshCertCredLibPath+vaultCredLibStringUpdate,
+	vaultSshCertCredLibUsername+vaultCredLibStringUpdate)
+
+func TestAccCredentialLibraryVaultSshCertificate(t *testing.T) {
+	skipForTestControllerVersion(t, "0.12.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckCredentialLibraryVaultResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultSshCertCredLibResource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, NameKey, vaultSshCertCredLibName),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, DescriptionKey, vaultSshCertCredLibDesc),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultPathKey, vaultSshCertCredLibPath),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateUsernameKey, vaultSshCertCredLibUsername),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateKeyTypeKey, "ed25519"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateCriticalOptionsKey+".%", "0"),
+
+					testAccCheckCredentialLibraryVaultResourceExists(provider, vaultSshCertCredResc),
+				),
+			},
+			importStep(vaultSshCertCredResc),
+			{
+				// update
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultSshCertCredLibResourceUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, NameKey, vaultSshCertCredLibName+vaultCredLibStringUpdate),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, DescriptionKey, vaultSshCertCredLibDesc+vaultCredLibStringUpdate),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultPathKey, vaultSshCertCredLibPath+vaultCredLibStringUpdate),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateUsernameKey, vaultSshCertCredLibUsername+vaultCredLibStringUpdate),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateKeyTypeKey, "rsa"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateKeyBitsKey, "4096"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateTtlKey, "1h"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateKeyIdKey, "boundary"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, "critical_options.force-command", "/bin/bash"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, "critical_options.source-address", "10.0.0.0/8,192.168.1.1"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, "extensions.permit-pty", ""),
+
+					testAccCheckCredentialLibraryVaultResourceExists(provider, vaultSshCertCredResc),
+				),
+			},
+			importStep(vaultSshCertCredResc),
+			{
+				// removing the options clears them
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultSshCertCredLibResource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateCriticalOptionsKey+".%", "0"),
+					resource.TestCheckResourceAttr(vaultSshCertCredResc, credentialLibraryVaultSshCertificateExtensionsKey+".%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateSshCertificateOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate schema.SchemaValidateFunc
+		options  map[string]interface{}
+		wantErr  string
+	}{
+		{name: "force-command", validate: validateSshCertificateCriticalOptions, options: map[string]interface{}{"force-command": "/bin/true"}},
+		{name: "source-address", validate: validateSshCertificateCriticalOptions, options: map[string]interface{}{"source-address": "10.0.0.0/8, 2001:db8::1"}},
+		{name: "vendor-option", validate: validateSshCertificateCriticalOptions, options: map[string]interface{}{"verify-required@openssh.com": ""}},
+		{name: "empty-force-command", validate: validateSshCertificateCriticalOptions, options: map[string]interface{}{"force-command": ""}, wantErr: "requires a command"},
+		{name: "invalid-source-address", validate: validateSshCertificateCriticalOptions, options: map[string]interface{}{"source-address": "10.0.0.0/33"}, wantErr: "invalid address or CIDR block"},
+		{name: "unknown-option", validate: validateSshCertificateCriticalOptions, options: map[string]interface{}{"permit-pty": ""}, wantErr: "unknown option"},
+		{name: "permit-pty", validate: validateSshCertificateExtensions, options: map[string]interface{}{"permit-pty": ""}},
+		{name: "vendor-extension", validate: validateSshCertificateExtensions, options: map[string]interface{}{"login@github.com": "octocat"}},
+		{name: "extension-value", validate: validateSshCertificateExtensions, options: map[string]interface{}{"permit-pty": "yes"}, wantErr: "must have an empty value"},
+		{name: "unknown-extension", validate: validateSshCertificateExtensions, options: map[string]interface{}{"permit-everything": ""}, wantErr: "unknown extension"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := tt.validate(tt.options, "options")
+			if tt.wantErr != "" {
+				require.Len(t, errs, 1)
+				assert.ErrorContains(t, errs[0], tt.wantErr)
+				return
+			}
+			assert.Empty(t, errs)
+		})
+	}
+}
+
+func TestResourceCredentialLibraryVaultSshCertificateCreate(t *testing.T) {
+	attrs := map[string]interface{}{
+		"path":     vaultSshCertCredLibPath,
+		"username": vaultSshCertCredLibUsername,
+		"key_type": "rsa",
+		"key_bits": 4096,
+		"ttl":      "1h",
+		"key_id":   "boundary",
+		"critical_options": map[string]interface{}{
+			"force-command": "/bin/bash",
+		},
+		"extensions": map[string]interface{}{
+			"permit-pty": "",
+		},
+	}
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/credential-libraries", &body, map[string]interface{}{
+		"id":                  "clvsclt_1234567890",
+		"credential_store_id": "csvlt_1234567890",
+		"name":                vaultSshCertCredLibName,
+		"type":                "vault-ssh-certificate",
+		"attributes":          attrs,
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCredentialLibraryVaultSshCertificate().Schema, map[string]interface{}{
+		NameKey:              vaultSshCertCredLibName,
+		credentialStoreIdKey: "csvlt_1234567890",
+		"path":               vaultSshCertCredLibPath,
+		"username":           vaultSshCertCredLibUsername,
+		"key_type":           "rsa",
+		"key_bits":           4096,
+		"ttl":                "1h",
+		"key_id":             "boundary",
+		"critical_options":   map[string]interface{}{"force-command": "/bin/bash"},
+		"extensions":         map[string]interface{}{"permit-pty": ""},
+	})
+	require.False(t, resourceCredentialLibraryVaultSshCertificateCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "vault-ssh-certificate", body["type"])
+	assert.Equal(t, "csvlt_1234567890", body["credential_store_id"])
+	// The fake controller decodes JSON numbers as float64
+	attrs["key_bits"] = float64(4096)
+	assert.Equal(t, attrs, body["attributes"])
+
+	assert.Equal(t, "clvsclt_1234567890", d.Id())
+	assert.Equal(t, 4096, d.Get("key_bits"))
+	assert.Equal(t, map[string]interface{}{"force-command": "/bin/bash"}, d.Get("critical_options"))
+	assert.Equal(t, map[string]interface{}{"permit-pty": ""}, d.Get("extensions"))
+}
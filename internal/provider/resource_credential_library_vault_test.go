@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -202,6 +203,7 @@ func TestAccCredentialLibraryVault(t *testing.T) {
 				),
 			},
 			importStep(vaultCredResc),
+			importStep(vaultCredTypedResc),
 
 			{
 				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, vaultCredLibResourceUpdate, vaultUsernamePasswordMappingOverrideCredLibResource),
@@ -305,7 +307,7 @@ func testAccCheckCredentialLibraryVaultResourceDestroy(t *testing.T, testProvide
 
 		for _, rs := range s.RootModule().Resources {
 			switch rs.Type {
-			case "boundary_credential_library_vault":
+			case "boundary_credential_library_vault", "boundary_credential_library_vault_ssh_certificate":
 				id := rs.Primary.ID
 
 				c := credentiallibraries.NewClient(md.client)
@@ -321,3 +323,39 @@ func testAccCheckCredentialLibraryVaultResourceDestroy(t *testing.T, testProvide
 		return nil
 	}
 }
+
+func TestCustomizeDiffCredentialMappingOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		credType  string
+		overrides map[string]interface{}
+		wantErr   string
+	}{
+		{name: "no-overrides", credType: "username_password"},
+		{name: "username-password", credType: "username_password", overrides: map[string]interface{}{"password_attribute": "pass"}},
+		{name: "ssh-private-key", credType: "ssh_private_key", overrides: map[string]interface{}{"private_key_attribute": "key", "username_attribute": "user"}},
+		{name: "without-type", overrides: map[string]interface{}{"username_attribute": "user"}, wantErr: `can only be used together with "credential_type"`},
+		{name: "invalid-key", credType: "username_password", overrides: map[string]interface{}{"private_key_attribute": "key"}, wantErr: `invalid credential mapping override "private_key_attribute"`},
+		{name: "unsupported-type", credType: "json", overrides: map[string]interface{}{"username_attribute": "user"}, wantErr: `not supported for credentials of type "json"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				credentialStoreIdKey:          "csvlt_1234567890",
+				credentialLibraryVaultPathKey: "secret/foo",
+			}
+			if tt.credType != "" {
+				raw[credentialLibraryCredentialTypeKey] = tt.credType
+			}
+			if tt.overrides != nil {
+				raw[credentialLibraryCredentialMappingOverridesKey] = tt.overrides
+			}
+			_, err := resourceCredentialLibraryVault().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -5,12 +5,23 @@ package provider
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentials"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/crypto/ssh"
 )
 
 const (
@@ -20,6 +31,13 @@ const (
 	credentialSshPrivateKeyPassphraseKey     = "private_key_passphrase"
 	credentialSshPrivateKeyPassphraseHmacKey = "private_key_passphrase_hmac"
 	credentialSshPrivateKeyCredentialType    = "ssh_private_key"
+	credentialSshPrivateKeyGenerateKeyType   = "generate_key_type"
+	credentialSshPrivateKeyGeneratedKeyKey   = "generated_private_key"
+	credentialSshPrivateKeyPublicKeyKey      = "public_key"
+
+	sshKeyTypeEd25519 = "ed25519"
+	sshKeyTypeRsa     = "rsa"
+	sshRsaKeyBits     = 4096
 )
 
 func resourceCredentialSshPrivateKey() *schema.Resource {
@@ -33,6 +51,15 @@ func resourceCredentialSshPrivateKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffCredentialSshPrivateKeyGenerate,
+			checkPermissions(permissionCheck{
+				collection:       "credentials",
+				parentKey:        credentialStoreIdKey,
+				parentCollection: "credential-stores",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -62,11 +89,33 @@ func resourceCredentialSshPrivateKey() *schema.Resource {
 				Required:    true,
 			},
 			credentialSshPrivateKeyPrivateKeyKey: {
-				Description: "The private key associated with the credential.",
+				Description: "The private key associated with the credential. Required unless `generate_key_type` is set.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
+			credentialSshPrivateKeyGenerateKeyType: {
+				Description: "The type of key pair the provider generates when planning the creation of the credential, " +
+					"either `ed25519` or `rsa`. The private key is stored in Boundary and the public key is available " +
+					"in `public_key`, to be distributed to the targets. Cannot be used together with `private_key`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{sshKeyTypeEd25519, sshKeyTypeRsa}, false),
+			},
+			credentialSshPrivateKeyGeneratedKeyKey: {
+				Description: "The private key generated by the provider when `generate_key_type` is set. Boundary only " +
+					"returns its HMAC, so it is empty after importing the credential.",
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			credentialSshPrivateKeyPublicKeyKey: {
+				Description: "The public key generated by the provider when `generate_key_type` is set, in the OpenSSH authorized keys format. " +
+					"It is empty after importing the credential.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			credentialSshPrivateKeyPrivateKeyHmacKey: {
 				Description: "The private key hmac.",
 				Type:        schema.TypeString,
@@ -87,6 +136,64 @@ func resourceCredentialSshPrivateKey() *schema.Resource {
 	}
 }
 
+// customizeDiffCredentialSshPrivateKeyGenerate makes sure exactly one of the
+// private key and the key type to generate is set, and generates the key pair
+// when planning a new credential so the public key is known before apply.
+func customizeDiffCredentialSshPrivateKeyGenerate(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	keyType := d.Get(credentialSshPrivateKeyGenerateKeyType).(string)
+	privateKeyKnown := d.NewValueKnown(credentialSshPrivateKeyPrivateKeyKey)
+	switch {
+	case keyType != "" && d.Get(credentialSshPrivateKeyPrivateKeyKey).(string) != "":
+		return fmt.Errorf(`"private_key" cannot be set when "generate_key_type" is set`)
+	case keyType == "" && privateKeyKnown && d.NewValueKnown(credentialSshPrivateKeyGenerateKeyType) &&
+		d.Get(credentialSshPrivateKeyPrivateKeyKey).(string) == "":
+		return fmt.Errorf(`one of "private_key" or "generate_key_type" must be set`)
+	}
+
+	if d.Id() != "" || keyType == "" {
+		return nil
+	}
+	privateKey, publicKey, err := generateSshKeyPair(keyType)
+	if err != nil {
+		return err
+	}
+	if err := d.SetNew(credentialSshPrivateKeyGeneratedKeyKey, privateKey); err != nil {
+		return err
+	}
+	return d.SetNew(credentialSshPrivateKeyPublicKeyKey, publicKey)
+}
+
+// generateSshKeyPair generates a key pair of the given type and returns the
+// PEM encoded private key and the public key in the authorized keys format.
+func generateSshKeyPair(keyType string) (string, string, error) {
+	var privateKey crypto.Signer
+	var err error
+	switch keyType {
+	case sshKeyTypeEd25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case sshKeyTypeRsa:
+		privateKey, err = rsa.GenerateKey(rand.Reader, sshRsaKeyBits)
+	default:
+		return "", "", fmt.Errorf("unsupported key type %q", keyType)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("error generating %s key: %w", keyType, err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("error encoding private key: %w", err)
+	}
+	publicKey, err := ssh.NewPublicKey(privateKey.Public())
+	if err != nil {
+		return "", "", fmt.Errorf("error encoding public key: %w", err)
+	}
+
+	privatePem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey)))
+	return string(privatePem), authorizedKey, nil
+}
+
 func setFromCredentialSshPrivateKeyResponseMap(d *schema.ResourceData, raw map[string]interface{}, fromRead bool) error {
 	if err := d.Set(NameKey, raw[NameKey]); err != nil {
 		return err
@@ -160,6 +267,26 @@ func resourceCredentialSshPrivateKeyCreate(ctx context.Context, d *schema.Resour
 	if v, ok := d.GetOk(credentialSshPrivateKeyPassphraseKey); ok {
 		opts = append(opts, credentials.WithSshPrivateKeyCredentialPrivateKeyPassphrase(v.(string)))
 	}
+	if keyType, ok := d.GetOk(credentialSshPrivateKeyGenerateKeyType); ok {
+		// The key pair is normally generated while planning, but the key type
+		// may only have been known once applying
+		privateKey := d.Get(credentialSshPrivateKeyGeneratedKeyKey).(string)
+		if privateKey == "" {
+			var publicKey string
+			var err error
+			privateKey, publicKey, err = generateSshKeyPair(keyType.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set(credentialSshPrivateKeyGeneratedKeyKey, privateKey); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set(credentialSshPrivateKeyPublicKeyKey, publicKey); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		opts = append(opts, credentials.WithSshPrivateKeyCredentialPrivateKey(privateKey))
+	}
 
 	var credentialStoreId string
 	retrievedStoreId, ok := d.GetOk(credentialStoreIdKey)
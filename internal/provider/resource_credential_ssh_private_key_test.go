@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
@@ -15,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/testdata"
 )
 
@@ -110,6 +115,67 @@ func TestAccCredentialSshPrivateKey(t *testing.T) {
 	})
 }
 
+func TestAccCredentialSshPrivateKeyGenerated(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	res := func(keyType string) string {
+		return fmt.Sprintf(`
+resource "boundary_credential_ssh_private_key" "example" {
+	name                = "generated"
+	credential_store_id = boundary_credential_store_static.ssh_store.id
+	username            = "my-user"
+	generate_key_type   = %q
+}`, keyType)
+	}
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckCredentialSshPrivateKeyResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, staticStore, res(sshKeyTypeEd25519)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sshPrivateKeyCredResc, credentialSshPrivateKeyPrivateKeyKey, ""),
+					resource.TestMatchResourceAttr(sshPrivateKeyCredResc, credentialSshPrivateKeyPublicKeyKey, regexp.MustCompile(`^ssh-ed25519 `)),
+					resource.TestCheckResourceAttrSet(sshPrivateKeyCredResc, credentialSshPrivateKeyGeneratedKeyKey),
+					testAccCheckCredentialStoreSshPrivateKeyHmac(provider),
+					testAccCheckCredentialSshPrivateKeyResourceExists(provider, sshPrivateKeyCredResc),
+				),
+			},
+			importStep(sshPrivateKeyCredResc, credentialSshPrivateKeyGenerateKeyType, credentialSshPrivateKeyGeneratedKeyKey, credentialSshPrivateKeyPublicKeyKey),
+			{
+				// changing the key type replaces the credential
+				Config: testConfig(url, fooOrg, firstProjectFoo, staticStore, res(sshKeyTypeRsa)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(sshPrivateKeyCredResc, credentialSshPrivateKeyPublicKeyKey, regexp.MustCompile(`^ssh-rsa `)),
+					testAccCheckCredentialStoreSshPrivateKeyHmac(provider),
+					testAccCheckCredentialSshPrivateKeyResourceExists(provider, sshPrivateKeyCredResc),
+				),
+			},
+		},
+	})
+}
+
+func TestGenerateSshKeyPair(t *testing.T) {
+	for _, keyType := range []string{sshKeyTypeEd25519, sshKeyTypeRsa} {
+		t.Run(keyType, func(t *testing.T) {
+			privateKey, publicKey, err := generateSshKeyPair(keyType)
+			require.NoError(t, err)
+
+			signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+			require.NoError(t, err)
+			assert.Equal(t, publicKey, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))))
+		})
+	}
+
+	_, _, err := generateSshKeyPair("dsa")
+	assert.Error(t, err)
+}
+
 func testAccCheckCredentialSshPrivateKeyResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
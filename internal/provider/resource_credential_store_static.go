@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentialstores"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,6 +27,15 @@ func resourceCredentialStoreStatic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			checkPermissions(permissionCheck{
+				collection:       "credential-stores",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -44,10 +54,11 @@ func resourceCredentialStoreStatic() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for this credential store.",
+				Description: "The scope for this credential store." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Required:    true,
 			},
 		},
 	}
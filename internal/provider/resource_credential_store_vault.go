@@ -5,13 +5,18 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/credentialstores"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vaultapi "github.com/hashicorp/vault/api"
 )
 
 const (
@@ -25,6 +30,10 @@ const (
 	credentialStoreVaultClientCertificateKey        = "client_certificate"
 	credentialStoreVaultClientCertificateKeyKey     = "client_certificate_key"
 	credentialStoreVaultClientCertificateKeyHmacKey = "client_certificate_key_hmac"
+	credentialStoreVaultWorkerFilterKey             = "worker_filter"
+	credentialStoreVaultTokenWrappedKey             = "token_wrapped"
+	credentialStoreVaultTokenRotateTriggerKey       = "token_rotate_trigger"
+	credentialStoreVaultTokenStatusKey              = "token_status"
 	credentialStoreType                             = "vault"
 )
 
@@ -35,6 +44,7 @@ var storeVaultAttrs = []string{
 	credentialStoreVaultTlsServerNameKey,
 	credentialStoreVaultTlsSkipVerifyKey,
 	credentialStoreVaultClientCertificateKey,
+	credentialStoreVaultWorkerFilterKey,
 }
 
 func resourceCredentialStoreVault() *schema.Resource {
@@ -48,6 +58,18 @@ func resourceCredentialStoreVault() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			requireControllerVersion("0.12.0", `The "worker_filter" of Vault credential stores`, func(d *schema.ResourceDiff) bool {
+				return d.Get(credentialStoreVaultWorkerFilterKey).(string) != ""
+			}),
+			checkPermissions(permissionCheck{
+				collection:       "credential-stores",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -66,10 +88,11 @@ func resourceCredentialStoreVault() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for this credential store.",
+				Description: "The scope for this credential store." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Required:    true,
 			},
 			credentialStoreVaultAddressKey: {
 				Description: "The address to Vault server. This should be a complete URL such as 'https://127.0.0.1:8200'",
@@ -97,16 +120,35 @@ func resourceCredentialStoreVault() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreVaultTokenKey: {
-				Description: "A token used for accessing Vault.",
+				Description: "A token used for accessing Vault. Changing the token rotates it in place.",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
 			},
+			credentialStoreVaultTokenWrappedKey: {
+				Description: "Whether `token` is a response-wrapping token. The provider unwraps it with Vault, using the " +
+					"connection settings of the credential store, and hands the unwrapped token to Boundary. As wrapping " +
+					"tokens can only be used once, the provider must be able to reach Vault whenever the token changes.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			credentialStoreVaultTokenRotateTriggerKey: {
+				Description: "An arbitrary value that causes `token` to be sent to Boundary again whenever it changes, e.g. " +
+					"from a `time_rotating` resource. Boundary refuses tokens it has already seen, so `token` is expected " +
+					"to be re-created or re-wrapped on the same schedule.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			credentialStoreVaultTokenHmacKey: {
 				Description: "The Vault token hmac.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			credentialStoreVaultTokenStatusKey: {
+				Description: "The status of the Vault token as seen by Boundary, one of `current`, `maintaining`, `revoked` or `expired`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			credentialStoreVaultClientCertificateKey: {
 				Description: "A PEM-encoded client certificate to use for TLS authentication to the Vault server.",
 				Type:        schema.TypeString,
@@ -123,6 +165,13 @@ func resourceCredentialStoreVault() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			credentialStoreVaultWorkerFilterKey: {
+				Description: "A boolean expression filtering the workers that are allowed to reach Vault, " +
+					"e.g. `\"vault\" in \"/tags/type\"`, to use a Vault cluster that is only reachable from a private network. " +
+					"Only supported by HCP Boundary and Boundary Enterprise.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -149,7 +198,10 @@ func setFromVaultCredentialStoreResponseMap(d *schema.ResourceData, raw map[stri
 		}
 
 		boundaryTokenHmac, ok := attrs[credentialStoreVaultTokenHmacKey]
-		tokenStatus := attrs["token_status"]
+		tokenStatus := attrs[credentialStoreVaultTokenStatusKey]
+		if err := d.Set(credentialStoreVaultTokenStatusKey, tokenStatus); err != nil {
+			return diag.FromErr(err)
+		}
 		switch {
 		case ok:
 			boundaryTokenHmacStr := boundaryTokenHmac.(string)
@@ -194,6 +246,58 @@ func setFromVaultCredentialStoreResponseMap(d *schema.ResourceData, raw map[stri
 	return diags
 }
 
+// vaultTokenFromConfig returns the token to hand to Boundary, unwrapping it
+// first when it is a response-wrapping token.
+func vaultTokenFromConfig(d *schema.ResourceData) (string, error) {
+	token := d.Get(credentialStoreVaultTokenKey).(string)
+	if !d.Get(credentialStoreVaultTokenWrappedKey).(bool) {
+		return token, nil
+	}
+
+	config := vaultapi.DefaultConfig()
+	if config.Error != nil {
+		return "", config.Error
+	}
+	config.Address = d.Get(credentialStoreVaultAddressKey).(string)
+
+	tlsConfig := config.HttpClient.Transport.(*http.Transport).TLSClientConfig
+	tlsConfig.ServerName = d.Get(credentialStoreVaultTlsServerNameKey).(string)
+	tlsConfig.InsecureSkipVerify = d.Get(credentialStoreVaultTlsSkipVerifyKey).(bool)
+	if caCert := d.Get(credentialStoreVaultCaCertKey).(string); caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return "", errors.New("no valid certificate found in ca_cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if clientCert := d.Get(credentialStoreVaultClientCertificateKey).(string); clientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(d.Get(credentialStoreVaultClientCertificateKeyKey).(string)))
+		if err != nil {
+			return "", fmt.Errorf("error parsing client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	client, err := vaultapi.NewClient(config)
+	if err != nil {
+		return "", fmt.Errorf("error creating Vault client: %w", err)
+	}
+	if namespace := d.Get(credentialStoreVaultNamespaceKey).(string); namespace != "" {
+		client.SetNamespace(namespace)
+	}
+	// The wrapping token authenticates the unwrap request itself
+	client.SetToken(token)
+
+	secret, err := client.Logical().Unwrap("")
+	if err != nil {
+		return "", fmt.Errorf("error unwrapping Vault token: %w", err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return "", errors.New("the wrapped response doesn't contain a Vault token")
+	}
+	return secret.Auth.ClientToken, nil
+}
+
 func resourceCredentialStoreVaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 
@@ -225,8 +329,15 @@ func resourceCredentialStoreVaultCreate(ctx context.Context, d *schema.ResourceD
 	if v, ok := d.GetOk(credentialStoreVaultClientCertificateKeyKey); ok {
 		opts = append(opts, credentialstores.WithVaultCredentialStoreClientCertificateKey(v.(string)))
 	}
-	if v, ok := d.GetOk(credentialStoreVaultTokenKey); ok {
-		opts = append(opts, credentialstores.WithVaultCredentialStoreToken(v.(string)))
+	if _, ok := d.GetOk(credentialStoreVaultTokenKey); ok {
+		token, err := vaultTokenFromConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts = append(opts, credentialstores.WithVaultCredentialStoreToken(token))
+	}
+	if v, ok := d.GetOk(credentialStoreVaultWorkerFilterKey); ok {
+		opts = append(opts, credentialstores.WithVaultCredentialStoreWorkerFilter(v.(string)))
 	}
 
 	var scope string
@@ -336,10 +447,13 @@ func resourceCredentialStoreVaultUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if d.HasChange(credentialStoreVaultTokenKey) {
-		v, ok := d.GetOk(credentialStoreVaultTokenKey)
-		if ok {
-			opts = append(opts, credentialstores.WithVaultCredentialStoreToken(v.(string)))
+	if d.HasChanges(credentialStoreVaultTokenKey, credentialStoreVaultTokenWrappedKey, credentialStoreVaultTokenRotateTriggerKey) {
+		if _, ok := d.GetOk(credentialStoreVaultTokenKey); ok {
+			token, err := vaultTokenFromConfig(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			opts = append(opts, credentialstores.WithVaultCredentialStoreToken(token))
 		}
 	}
 
@@ -359,6 +473,14 @@ func resourceCredentialStoreVaultUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange(credentialStoreVaultWorkerFilterKey) {
+		opts = append(opts, credentialstores.DefaultVaultCredentialStoreWorkerFilter())
+		v, ok := d.GetOk(credentialStoreVaultWorkerFilterKey)
+		if ok {
+			opts = append(opts, credentialstores.WithVaultCredentialStoreWorkerFilter(v.(string)))
+		}
+	}
+
 	if len(opts) > 0 {
 		opts = append(opts, credentialstores.WithAutomaticVersioning(true))
 		crUpdate, err := client.Update(ctx, d.Id(), 0, opts...)
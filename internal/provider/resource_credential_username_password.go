@@ -31,6 +31,12 @@ func resourceCredentialUsernamePassword() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "credentials",
+			parentKey:        credentialStoreIdKey,
+			parentCollection: "credential-stores",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -91,9 +97,9 @@ func setFromCredentialUsernamePasswordResponseMap(d *schema.ResourceData, raw ma
 			return err
 		}
 
-		statePasswordHmac := d.Get(credentialUsernamePasswordPasswordHmacKey)
-		boundaryPasswordHmac := attrs[credentialUsernamePasswordPasswordHmacKey].(string)
-		if statePasswordHmac.(string) != boundaryPasswordHmac && fromRead {
+		statePasswordHmac := d.Get(credentialUsernamePasswordPasswordHmacKey).(string)
+		boundaryPasswordHmac, _ := attrs[credentialUsernamePasswordPasswordHmacKey].(string)
+		if statePasswordHmac != boundaryPasswordHmac && fromRead {
 			// PasswordHmac has changed in Boundary, therefore the password has changed.
 			// Update password value to force tf to attempt update.
 			if err := d.Set(credentialUsernamePasswordPasswordKey, "(changed in Boundary)"); err != nil {
@@ -111,6 +111,20 @@ func TestAccCredentialUsernamePassword(t *testing.T) {
 				Config:    testConfig(url, fooOrg, firstProjectFoo, resUpdate),
 			},
 			importStep(usernamePasswordCredResc, credentialUsernamePasswordPasswordKey),
+			{
+				// change the password outside of Terraform, it must be set back
+				PreConfig: func() { usernamePasswordCredExternalPasswordUpdate(t, provider) },
+				Config:    testConfig(url, fooOrg, firstProjectFoo, resUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(usernamePasswordCredResc, credentialUsernamePasswordPasswordKey, usernamePasswordCredPassword+usernamePasswordCredUpdate),
+					testAccCheckCredentialUsernamePasswordHmacMatches(provider),
+				),
+			},
+			{
+				// Run a plan only update and verify no changes
+				PlanOnly: true,
+				Config:   testConfig(url, fooOrg, firstProjectFoo, resUpdate),
+			},
 		},
 	})
 }
@@ -137,6 +151,44 @@ func usernamePasswordCredExternalUpdate(t *testing.T, testProvider *schema.Provi
 	}
 }
 
+func usernamePasswordCredExternalPasswordUpdate(t *testing.T, testProvider *schema.Provider) {
+	if storeId == "" {
+		t.Fatal("storeId must be set before testing an external update")
+	}
+
+	md := testProvider.Meta().(*metaData)
+	c := credentials.NewClient(md.client)
+	_, err := c.Update(context.Background(), storeId, 0,
+		credentials.WithUsernamePasswordCredentialPassword("changed outside of terraform"),
+		credentials.WithAutomaticVersioning(true))
+	if err != nil {
+		t.Fatal(fmt.Errorf("got an error updating %q: %w", storeId, err))
+	}
+}
+
+func testAccCheckCredentialUsernamePasswordHmacMatches(testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[usernamePasswordCredResc]
+		if !ok {
+			return fmt.Errorf("not found: %s", usernamePasswordCredResc)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		c := credentials.NewClient(md.client)
+		cr, err := c.Read(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("got an error reading %q: %w", rs.Primary.ID, err)
+		}
+
+		got := rs.Primary.Attributes[credentialUsernamePasswordPasswordHmacKey]
+		if want := cr.Item.Attributes[credentialUsernamePasswordPasswordHmacKey]; got != want {
+			return fmt.Errorf("password hmac in state %q doesn't match the one in Boundary %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCredentialUsernamePasswordResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/groups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	groupIdKey = "group_id"
+	userIdKey  = "user_id"
+)
+
+func resourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "The group membership resource adds a single user to an existing group, leaving the other " +
+			"members of the group alone. The `boundary_group` resource managing the group should not set " +
+			"`member_ids`, and should ignore changes to them with a `lifecycle` block.",
+
+		CreateContext: resourceGroupMembershipCreate,
+		ReadContext:   resourceGroupMembershipRead,
+		DeleteContext: resourceGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			parentKey:        groupIdKey,
+			parentCollection: "groups",
+			parentAction:     "add-members",
+		}),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the group membership, in the form `<group_id>:<user_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			groupIdKey: {
+				Description: "The ID of the group to add the user to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			userIdKey: {
+				Description: "The ID of the user to add to the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// parseGroupMembershipId splits the ID of a group membership into the ID of
+// the group and the user.
+func parseGroupMembershipId(id string) (string, string, error) {
+	groupId, userId, ok := strings.Cut(id, ":")
+	if !ok || groupId == "" || userId == "" {
+		return "", "", fmt.Errorf("invalid group membership ID %q, expected <group_id>:<user_id>", id)
+	}
+	return groupId, userId, nil
+}
+
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	groupId := d.Get(groupIdKey).(string)
+	userId := d.Get(userIdKey).(string)
+	defer md.locks.lock(groupId)()
+	_, err := grps.AddMembers(ctx, groupId, 0, []string{userId}, groups.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding member to group: %v", err)
+	}
+
+	d.SetId(groupId + ":" + userId)
+	return nil
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	groupId, userId, err := parseGroupMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grr, err := grps.Read(ctx, groupId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read group: %v", err)
+	}
+	if grr == nil {
+		return diag.Errorf("group nil after read")
+	}
+
+	found := false
+	for _, m := range grr.Item.MemberIds {
+		if m == userId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// The user was removed outside of Terraform, or deleted
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(groupIdKey, groupId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(userIdKey, userId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	groupId := d.Get(groupIdKey).(string)
+	defer md.locks.lock(groupId)()
+
+	_, err := grps.RemoveMembers(ctx, groupId, 0, []string{d.Get(userIdKey).(string)}, groups.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing member from group: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	sharedGroup = `
+resource "boundary_group" "shared" {
+	name       = "shared"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+
+	lifecycle {
+		ignore_changes = [member_ids]
+	}
+}`
+
+	sharedGroupMemberships = `
+resource "boundary_group_membership" "foo" {
+	group_id = boundary_group.shared.id
+	user_id  = boundary_user.foo.id
+}
+
+resource "boundary_group_membership" "bar" {
+	group_id = boundary_group.shared.id
+	user_id  = boundary_user.bar.id
+}`
+
+	sharedGroupMembershipsUpdate = `
+resource "boundary_group_membership" "foo" {
+	group_id = boundary_group.shared.id
+	user_id  = boundary_user.foo.id
+}`
+)
+
+func TestAccGroupMembership(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckGroupResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// add two members to the group
+				Config: testConfig(url, fooOrg, fooUser, barUser, sharedGroup, sharedGroupMemberships),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupResourceMembersSet(provider, "boundary_group.shared", []string{"boundary_user.foo", "boundary_user.bar"}),
+				),
+			},
+			importStep("boundary_group_membership.foo"),
+			importStep("boundary_group_membership.bar"),
+			{
+				// remove one of them, leaving the other alone
+				Config: testConfig(url, fooOrg, fooUser, barUser, sharedGroup, sharedGroupMembershipsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupResourceMembersSet(provider, "boundary_group.shared", []string{"boundary_user.foo"}),
+				),
+			},
+		},
+	})
+}
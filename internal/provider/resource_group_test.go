@@ -242,6 +242,41 @@ func TestAccGroupWithMembers(t *testing.T) {
 	})
 }
 
+func TestAccGroupDefaultScopeId(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	providerConfig := fmt.Sprintf(`
+provider "boundary" {
+	addr                            = "%s"
+	auth_method_id                  = "%s"
+	password_auth_method_login_name = "%s"
+	password_auth_method_password   = "%s"
+	default_scope_id                = "global"
+}`, url, tcPAUM, tcLoginName, tcPassword)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckGroupResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "boundary_group" "default_scope" {
+	name = "default-scope"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupResourceExists(provider, "boundary_group.default_scope"),
+					resource.TestCheckResourceAttr("boundary_group.default_scope", ScopeIdKey, "global"),
+				),
+			},
+			importStep("boundary_group.default_scope"),
+		},
+	})
+}
+
 func testAccCheckGroupResourceMembersSet(testProvider *schema.Provider, name string, members []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -13,20 +13,31 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"regexp"
 	"strconv"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/crypto/blake2b"
 )
 
 const (
 	hostCatalogTypePlugin = "plugin"
+
+	hostCatalogPluginAws   = "aws"
+	hostCatalogPluginAzure = "azure"
+	hostCatalogPluginGcp   = "gcp"
+
+	hostCatalogPluginCredentialsOwnedKey = "credentials_owned_by_boundary"
 )
 
+var awsRoleArnRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+
 func resourceHostCatalogPlugin() *schema.Resource {
 	return &schema.Resource{
 		Description: "The host catalog resource allows you to configure a Boundary plugin-type host catalog. Host " +
@@ -40,6 +51,7 @@ func resourceHostCatalogPlugin() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -58,9 +70,10 @@ func resourceHostCatalogPlugin() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created.",
+				Description: "The scope ID in which the resource is created." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			PluginIdKey: {
@@ -81,12 +94,23 @@ func resourceHostCatalogPlugin() *schema.Resource {
 			},
 			AttributesJsonKey: {
 				Description: `The attributes for the host catalog. Either values encoded with the "jsonencode" function, pre-escaped JSON string, ` +
-					`or a file:// or env:// path. Set to a string "null" or remove the block to clear all attributes in the host catalog.`,
+					`or a file:// or env:// path. Set to a string "null" or remove the block to clear all attributes in the host catalog. ` +
+					`"disable_credential_rotation" can be set here or with the attribute of the same name, but not both.`,
 				Type:     schema.TypeString,
 				Optional: true,
 				// If set to null in config and nothing comes from API, consider
 				// it the same. Same if config changes from empty to null.
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if !configuredCredentialRotation(d.GetRawConfig()).IsNull() {
+						// The controller returns the attribute set through
+						// disable_credential_rotation, it isn't part of the
+						// configured attributes
+						old = jsonWithoutKey(old, DisableCredentialRotationKey)
+						new = jsonWithoutKey(new, DisableCredentialRotationKey)
+						if old == new {
+							return true
+						}
+					}
 					sanitizedNew, err := sanitizeJson(new)
 					if err != nil {
 						return false
@@ -112,6 +136,21 @@ func resourceHostCatalogPlugin() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			DisableCredentialRotationKey: {
+				Description: "Whether the plugin must leave the credentials given in `secrets_json` as they are. When false, " +
+					"plugins supporting it, such as aws, azure and gcp, replace them with credentials only Boundary knows, so " +
+					"the ones originally supplied stop working. Sets the attribute of the same name for the plugin. If unset, " +
+					"the value given in `attributes_json` is used.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			hostCatalogPluginCredentialsOwnedKey: {
+				Description: "Whether Boundary owns the credentials of the host catalog, i.e. the plugin rotates the secrets " +
+					"and the credentials originally supplied are no longer valid.",
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			SecretsHmacKey: {
 				Description: "The HMAC'd secrets value returned from the server.",
 				Type:        schema.TypeString,
@@ -140,10 +179,253 @@ func resourceHostCatalogPlugin() *schema.Resource {
 
 		// We want to always force an update (which itself may not actually do
 		// anything) so that we can properly check secrets state.
-		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
-			return d.SetNewComputed(internalForceUpdateKey)
-		},
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if err := d.SetNewComputed(internalForceUpdateKey); err != nil {
+					return err
+				}
+				return customizeDiffDefaultScopeId(ctx, d, meta)
+			},
+			requireControllerVersion("0.16.0", `Host catalogs backed by the "gcp" plugin`, func(d *schema.ResourceDiff) bool {
+				return d.Get(PluginNameKey).(string) == hostCatalogPluginGcp
+			}),
+			customizeDiffHostCatalogPluginGcp,
+			requireControllerVersion("0.15.0", `Assuming a role with the "aws" plugin`, func(d *schema.ResourceDiff) bool {
+				if d.Get(PluginNameKey).(string) != hostCatalogPluginAws || !d.NewValueKnown(AttributesJsonKey) {
+					return false
+				}
+				_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+				return err == nil && attrs["role_arn"] != nil
+			}),
+			customizeDiffPluginCredentialRotation,
+			customizeDiffHostCatalogPluginAws,
+			customizeDiffHostCatalogPluginAzure,
+			checkPermissions(permissionCheck{
+				collection:       "host-catalogs",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
+	}
+}
+
+// customizeDiffHostCatalogPluginGcp checks at plan time that host catalogs
+// backed by the gcp plugin know which project and zone to list instances from.
+// Credentials are either the key of a service account, given with
+// "client_email" in the attributes and "private_key_id" and "private_key" in
+// the secrets, or the identity of the worker running the plugin, optionally
+// impersonating "target_service_account_id".
+func customizeDiffHostCatalogPluginGcp(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(AttributesJsonKey) || !d.NewValueKnown(PluginNameKey) {
+		return nil
+	}
+	if d.Get(PluginNameKey).(string) != hostCatalogPluginGcp {
+		return nil
+	}
+	_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+	if err != nil {
+		return err
+	}
+	for _, k := range []string{"project_id", "zone"} {
+		v, ok := attrs[k].(string)
+		if !ok || v == "" {
+			return fmt.Errorf("%q must be set in %q for host catalogs backed by the gcp plugin", k, AttributesJsonKey)
+		}
+	}
+	return nil
+}
+
+// customizeDiffHostCatalogPluginAws checks at plan time the attributes used by
+// host catalogs backed by the aws plugin to assume a role with STS. The role
+// settings only make sense along with "role_arn", and without static
+// credentials in the secrets the credentials of the worker are used, which
+// can't be rotated.
+func customizeDiffHostCatalogPluginAws(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(AttributesJsonKey) || !d.NewValueKnown(PluginNameKey) {
+		return nil
+	}
+	if d.Get(PluginNameKey).(string) != hostCatalogPluginAws {
+		return nil
+	}
+	_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+	if err != nil {
+		return err
+	}
+
+	roleArn, hasRoleArn := attrs["role_arn"]
+	if !hasRoleArn {
+		for _, k := range []string{"role_external_id", "role_session_name", "role_tags"} {
+			if _, ok := attrs[k]; ok {
+				return fmt.Errorf("%q in %q requires \"role_arn\"", k, AttributesJsonKey)
+			}
+		}
+		return nil
+	}
+	if v, ok := roleArn.(string); !ok || !awsRoleArnRegexp.MatchString(v) {
+		return fmt.Errorf("\"role_arn\" in %q must be the ARN of an IAM role, got %v", AttributesJsonKey, roleArn)
+	}
+	for _, k := range []string{"role_external_id", "role_session_name"} {
+		if v, ok := attrs[k]; ok {
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("%q in %q must be a string", k, AttributesJsonKey)
+			}
+		}
+	}
+	if v, ok := attrs["role_tags"]; ok {
+		tags, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("\"role_tags\" in %q must be an object of strings", AttributesJsonKey)
+		}
+		for k, tag := range tags {
+			if _, ok := tag.(string); !ok {
+				return fmt.Errorf("the value of the role tag %q in %q must be a string", k, AttributesJsonKey)
+			}
+		}
+	}
+
+	if secrets, ok := plannedPluginSecrets(d); ok && len(secrets) == 0 {
+		if !plannedCredentialRotationDisabled(d, attrs) {
+			return errors.New("\"disable_credential_rotation\" must be true when assuming a role without static credentials")
+		}
+	}
+	return nil
+}
+
+// customizeDiffHostCatalogPluginAzure checks at plan time the attributes of
+// host catalogs backed by the azure plugin. The client secret of an AD
+// application is optional: without "secret_value" in the secrets the plugin
+// authenticates with the managed identity or the federated credentials of the
+// worker, which can't be rotated.
+func customizeDiffHostCatalogPluginAzure(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(AttributesJsonKey) || !d.NewValueKnown(PluginNameKey) {
+		return nil
+	}
+	if d.Get(PluginNameKey).(string) != hostCatalogPluginAzure {
+		return nil
+	}
+	_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+	if err != nil {
+		return err
+	}
+	for _, k := range []string{"tenant_id", "subscription_id"} {
+		v, ok := attrs[k].(string)
+		if !ok || v == "" {
+			return fmt.Errorf("%q must be set in %q for host catalogs backed by the azure plugin", k, AttributesJsonKey)
+		}
+	}
+
+	secrets, ok := plannedPluginSecrets(d)
+	if !ok {
+		return nil
+	}
+	if _, ok := secrets["secret_value"]; ok {
+		if v, _ := attrs["client_id"].(string); v == "" {
+			return fmt.Errorf("\"client_id\" must be set in %q along with a client secret", AttributesJsonKey)
+		}
+		return nil
+	}
+	if !plannedCredentialRotationDisabled(d, attrs) {
+		return errors.New("\"disable_credential_rotation\" must be true when using a managed identity instead of a client secret")
+	}
+	return nil
+}
+
+// plannedPluginSecrets returns the secrets planned for the plugin. ok is false
+// when they aren't known, or when secrets were sent before and have been
+// removed from the configuration since, as the plugin keeps them.
+func plannedPluginSecrets(d *schema.ResourceDiff) (secrets map[string]interface{}, ok bool) {
+	if !d.NewValueKnown(SecretsJsonKey) || d.Get(SecretsHmacKey).(string) != "" {
+		return nil, false
+	}
+	_, secrets, err := parsePluginJson(d.Get(SecretsJsonKey).(string), "secrets")
+	if err != nil {
+		return nil, false
+	}
+	return secrets, true
+}
+
+// credentialRotationDisabled reports whether the "disable_credential_rotation"
+// attribute of a plugin is set, which is commonly given as a string as well.
+func credentialRotationDisabled(attrs map[string]interface{}) bool {
+	disabled := attrs["disable_credential_rotation"]
+	return disabled == true || disabled == "true"
+}
+
+// configuredCredentialRotation returns the disable_credential_rotation
+// attribute of the configuration, which is null when it isn't set.
+func configuredCredentialRotation(rawConfig cty.Value) cty.Value {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return cty.NullVal(cty.Bool)
+	}
+	return rawConfig.GetAttr(DisableCredentialRotationKey)
+}
+
+// plannedCredentialRotationDisabled reports whether credential rotation will be
+// disabled for the plugin, either with disable_credential_rotation or in the
+// attributes.
+func plannedCredentialRotationDisabled(d *schema.ResourceDiff, attrs map[string]interface{}) bool {
+	if v := configuredCredentialRotation(d.GetRawConfig()); !v.IsNull() {
+		return !v.IsKnown() || v.True()
+	}
+	return credentialRotationDisabled(attrs)
+}
+
+// customizeDiffPluginCredentialRotation makes sure credential rotation isn't
+// configured twice, as the attribute and in the attributes. When only the
+// attributes configure it, the attribute follows them.
+func customizeDiffPluginCredentialRotation(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if configuredCredentialRotation(d.GetRawConfig()).IsNull() {
+		if d.HasChange(AttributesJsonKey) {
+			return d.SetNewComputed(DisableCredentialRotationKey)
+		}
+		return nil
+	}
+	if !d.NewValueKnown(AttributesJsonKey) {
+		return nil
+	}
+	_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+	if err != nil {
+		return err
+	}
+	if _, ok := attrs[DisableCredentialRotationKey]; ok {
+		return fmt.Errorf("%q can't be set both as an attribute and in %q", DisableCredentialRotationKey, AttributesJsonKey)
+	}
+	return nil
+}
+
+// pluginAttributes returns the attributes to send to the plugin,
+// with disable_credential_rotation merged in when it is configured.
+func pluginAttributes(d *schema.ResourceData) (map[string]interface{}, error) {
+	_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+	if err != nil {
+		return nil, err
+	}
+	if v := configuredCredentialRotation(d.GetRawConfig()); !v.IsNull() && v.IsKnown() {
+		if attrs == nil {
+			attrs = make(map[string]interface{})
+		}
+		attrs[DisableCredentialRotationKey] = v.True()
+	}
+	return attrs, nil
+}
+
+// jsonWithoutKey returns the JSON object in, sanitized and without key. An
+// object left empty is returned as an empty string, and values that aren't
+// objects are returned as they are.
+func jsonWithoutKey(in, key string) string {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(in), &m); err != nil || m == nil {
+		return in
+	}
+	delete(m, key)
+	if len(m) == 0 {
+		return ""
 	}
+	out, err := json.Marshal(m)
+	if err != nil {
+		return in
+	}
+	return string(out)
 }
 
 func sanitizeJson(in string) ([]byte, error) {
@@ -335,6 +617,23 @@ func setFromHostCatalogPluginResponseMap(d *schema.ResourceData, raw map[string]
 			d.Set(AttributesJsonKey, nil)
 		}
 	}
+	// Credential rotation stuff
+	{
+		attrs, _ := raw["attributes"].(map[string]interface{})
+		disabled := credentialRotationDisabled(attrs)
+		if err := d.Set(DisableCredentialRotationKey, disabled); err != nil {
+			return err
+		}
+		secretsHmac, _ := raw[SecretsHmacKey].(string)
+		var owned bool
+		switch d.Get(PluginNameKey).(string) {
+		case hostCatalogPluginAws, hostCatalogPluginAzure, hostCatalogPluginGcp:
+			owned = secretsHmac != "" && !disabled
+		}
+		if err := d.Set(hostCatalogPluginCredentialsOwnedKey, owned); err != nil {
+			return err
+		}
+	}
 	// Secrets stuff
 	{
 		// We do not save secrets into the state file, and they're not returned in
@@ -399,24 +698,12 @@ func resourceHostCatalogPluginCreate(ctx context.Context, d *schema.ResourceData
 		opts = append(opts, hostcatalogs.WithDescription(descStr))
 	}
 
-	attrsVal, ok := d.GetOk(AttributesJsonKey)
-	if ok {
-		attrsStr, err := parseutil.ParsePath(attrsVal.(string))
-		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
-			return diag.Errorf("error parsing path with attributes: %v", err)
-		}
-		switch attrsStr {
-		case "null":
-			opts = append(opts, hostcatalogs.DefaultAttributes())
-		default:
-			// What comes in is json-encoded but we want to set a
-			// map[string]interface{} so we unmarshal it and set that
-			var m map[string]interface{}
-			if err := json.Unmarshal([]byte(attrsStr), &m); err != nil {
-				return diag.Errorf("error unmarshaling attributes: %v", err)
-			}
-			opts = append(opts, hostcatalogs.WithAttributes(m))
-		}
+	attrs, err := pluginAttributes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if attrs != nil {
+		opts = append(opts, hostcatalogs.WithAttributes(attrs))
 	}
 
 	secretsVal, ok := d.GetOk(SecretsJsonKey)
@@ -556,27 +843,15 @@ func resourceHostCatalogPluginUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	if d.HasChange(AttributesJsonKey) {
-		attrsVal, ok := d.GetOk(AttributesJsonKey)
-		if ok {
-			attrsStr, err := parseutil.ParsePath(attrsVal.(string))
-			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
-				return append(currentDiagnostics, diag.Errorf("error parsing path with attributes: %v", err)...)
-			}
-			switch attrsStr {
-			case "null", "":
-				opts = append(opts, hostcatalogs.DefaultAttributes())
-			default:
-				// What comes in is json-encoded but we want to set a
-				// map[string]interface{} so we unmarshal it and set that
-				var m map[string]interface{}
-				if err := json.Unmarshal([]byte(attrsStr), &m); err != nil {
-					return append(currentDiagnostics, diag.Errorf("error unmarshaling attributes: %v", err)...)
-				}
-				opts = append(opts, hostcatalogs.WithAttributes(m))
-			}
-		} else {
+	if d.HasChanges(AttributesJsonKey, DisableCredentialRotationKey) {
+		attrs, err := pluginAttributes(d)
+		if err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+		if attrs == nil {
 			opts = append(opts, hostcatalogs.DefaultAttributes())
+		} else {
+			opts = append(opts, hostcatalogs.WithAttributes(attrs))
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	_ "github.com/kr/pretty" // So I don't have to keep adding to/removing from go.mod :-)
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -392,3 +394,211 @@ func testAccCheckPluginHostCatalogResourceDestroy(t *testing.T, testProvider *sc
 		return nil
 	}
 }
+
+func TestCustomizeDiffHostCatalogPluginGcp(t *testing.T) {
+	tests := []struct {
+		name       string
+		pluginName string
+		attributes string
+		wantErr    string
+	}{
+		{name: "aws", pluginName: "aws", attributes: `{"region":"us-east-1"}`},
+		{name: "gcp-service-account", pluginName: "gcp", attributes: `{"project_id":"my-project","zone":"us-central1-a","client_email":"boundary@my-project.iam.gserviceaccount.com"}`},
+		{name: "gcp-workload-identity", pluginName: "gcp", attributes: `{"project_id":"my-project","zone":"us-central1-a","disable_credential_rotation":true}`},
+		{name: "gcp-without-project", pluginName: "gcp", attributes: `{"zone":"us-central1-a"}`, wantErr: `"project_id" must be set`},
+		{name: "gcp-without-zone", pluginName: "gcp", attributes: `{"project_id":"my-project"}`, wantErr: `"zone" must be set`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				ScopeIdKey:        "p_1234567890",
+				PluginNameKey:     tt.pluginName,
+				AttributesJsonKey: tt.attributes,
+			})
+			_, err := resourceHostCatalogPlugin().Diff(context.Background(), nil, config, &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCustomizeDiffHostCatalogPluginAws(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes string
+		secrets    string
+		wantErr    string
+	}{
+		{name: "static-credentials", attributes: `{"region":"us-east-1"}`, secrets: `{"access_key_id":"foo","secret_access_key":"bar"}`},
+		{
+			name:       "assume-role",
+			attributes: `{"region":"us-east-1","disable_credential_rotation":true,"role_arn":"arn:aws:iam::123456789012:role/boundary","role_external_id":"boundary","role_session_name":"boundary","role_tags":{"team":"ops"}}`,
+		},
+		{
+			name:       "assume-role-with-static-credentials",
+			attributes: `{"region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/boundary"}`,
+			secrets:    `{"access_key_id":"foo","secret_access_key":"bar"}`,
+		},
+		{name: "role-settings-without-role", attributes: `{"region":"us-east-1","role_session_name":"boundary"}`, wantErr: `"role_session_name" in "attributes_json" requires "role_arn"`},
+		{name: "invalid-role-arn", attributes: `{"region":"us-east-1","disable_credential_rotation":true,"role_arn":"boundary"}`, wantErr: `"role_arn" in "attributes_json" must be the ARN of an IAM role`},
+		{
+			name:       "invalid-role-tags",
+			attributes: `{"region":"us-east-1","disable_credential_rotation":true,"role_arn":"arn:aws:iam::123456789012:role/boundary","role_tags":{"team":1}}`,
+			wantErr:    `the value of the role tag "team"`,
+		},
+		{
+			name:       "rotation-without-static-credentials",
+			attributes: `{"region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/boundary"}`,
+			wantErr:    `"disable_credential_rotation" must be true`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				ScopeIdKey:        "p_1234567890",
+				PluginNameKey:     "aws",
+				AttributesJsonKey: tt.attributes,
+			}
+			if tt.secrets != "" {
+				raw[SecretsJsonKey] = tt.secrets
+			}
+			_, err := resourceHostCatalogPlugin().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCustomizeDiffHostCatalogPluginAzure(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes string
+		secrets    string
+		wantErr    string
+	}{
+		{
+			name:       "client-secret",
+			attributes: `{"tenant_id":"tenant","subscription_id":"subscription","client_id":"client"}`,
+			secrets:    `{"secret_value":"secret"}`,
+		},
+		{
+			name:       "managed-identity",
+			attributes: `{"tenant_id":"tenant","subscription_id":"subscription","disable_credential_rotation":"true"}`,
+		},
+		{
+			name:       "without-subscription",
+			attributes: `{"tenant_id":"tenant","disable_credential_rotation":true}`,
+			wantErr:    `"subscription_id" must be set`,
+		},
+		{
+			name:       "client-secret-without-client",
+			attributes: `{"tenant_id":"tenant","subscription_id":"subscription"}`,
+			secrets:    `{"secret_value":"secret"}`,
+			wantErr:    `"client_id" must be set`,
+		},
+		{
+			name:       "managed-identity-with-rotation",
+			attributes: `{"tenant_id":"tenant","subscription_id":"subscription"}`,
+			wantErr:    `"disable_credential_rotation" must be true`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				ScopeIdKey:        "p_1234567890",
+				PluginNameKey:     "azure",
+				AttributesJsonKey: tt.attributes,
+			}
+			if tt.secrets != "" {
+				raw[SecretsJsonKey] = tt.secrets
+			}
+			_, err := resourceHostCatalogPlugin().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAccPluginHostCatalogCredentialRotation(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	resName := "boundary_host_catalog_plugin.foo"
+	disabledHcl := fmt.Sprintf(projPluginHostCatalogBase, `
+	attributes_json = jsonencode({
+		foo = "bar"
+	})
+	disable_credential_rotation = true
+	`)
+	fromAttributesHcl := fmt.Sprintf(projPluginHostCatalogBase, `
+	attributes_json = jsonencode({
+		foo                         = "bar"
+		disable_credential_rotation = false
+	})
+	`)
+	conflictHcl := fmt.Sprintf(projPluginHostCatalogBase, `
+	attributes_json = jsonencode({
+		foo                         = "bar"
+		disable_credential_rotation = false
+	})
+	disable_credential_rotation = true
+	`)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckPluginHostCatalogResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, disabledHcl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, DisableCredentialRotationKey, "true"),
+					resource.TestCheckResourceAttr(resName, AttributesJsonKey, `{"disable_credential_rotation":true,"foo":"bar"}`),
+					// The loopback plugin doesn't rotate credentials
+					resource.TestCheckResourceAttr(resName, hostCatalogPluginCredentialsOwnedKey, "false"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			importStep(resName, SecretsJsonKey, internalHmacUsedForSecretsConfigHmacKey, internalForceUpdateKey, internalSecretsConfigHmacKey),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fromAttributesHcl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, DisableCredentialRotationKey, "false"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, conflictHcl),
+				ExpectError: regexp.MustCompile(`"disable_credential_rotation" can't be set both as an attribute and in\s+"attributes_json"`),
+			},
+		},
+	})
+}
+
+func TestJsonWithoutKey(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `{"foo":"bar","disable_credential_rotation":true}`, want: `{"foo":"bar"}`},
+		{in: `{"disable_credential_rotation":true}`, want: ""},
+		{in: `{"foo":"bar"}`, want: `{"foo":"bar"}`},
+		{in: "", want: ""},
+		{in: "null", want: "null"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, jsonWithoutKey(tt.in, DisableCredentialRotationKey))
+		})
+	}
+}
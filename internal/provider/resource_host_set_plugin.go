@@ -7,7 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hostsets"
@@ -19,6 +22,8 @@ import (
 
 const (
 	hostSetTypePlugin = "plugin"
+
+	hostSetPluginRefreshTriggerKey = "refresh_trigger"
 )
 
 func resourceHostSetPlugin() *schema.Resource {
@@ -34,6 +39,12 @@ func resourceHostSetPlugin() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "host-sets",
+			parentKey:        HostCatalogIdKey,
+			parentCollection: "host-catalogs",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -65,25 +76,45 @@ func resourceHostSetPlugin() *schema.Resource {
 				ForceNew:    true,
 			},
 			PreferredEndpointsKey: {
-				Description: "The ordered list of preferred endpoints.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ordered list of preferred endpoints, used to pick the address Boundary dials when a " +
+					"host has several. Each entry is either `cidr:` followed by an IP network, e.g. `cidr:10.0.0.0/8`, or " +
+					"`dns:` followed by a host name that may contain `*` wildcards, e.g. `dns:*.example.com`.",
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePreferredEndpoint,
+				},
 			},
 			SyncIntervalSecondsKey: {
-				Description: "The value to set for the sync interval seconds.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Description: "The number of seconds between syncs of the hosts of the host set with the plugin. " +
+					"Set to -1 to disable syncing; defaults to 0, which uses the controller's default interval.",
+				Type:     schema.TypeInt,
+				Optional: true,
 				ValidateDiagFunc: func(in interface{}, _ cty.Path) diag.Diagnostics {
 					val := in.(int)
 					switch {
 					case val >= -1:
 						return nil
 					default:
-						return diag.Errorf("invalid value for sync_interval_seconds")
+						return diag.Errorf("invalid value for sync_interval_seconds: must be -1, 0 or a positive number of seconds, got %d", val)
 					}
 				},
 			},
+			hostSetHostIdsKey: {
+				Description: "The IDs of the hosts the plugin synced into the host set. The hosts are discovered " +
+					"asynchronously, so the list may be empty right after the host set is created.",
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			hostSetPluginRefreshTriggerKey: {
+				Description: "An arbitrary value that makes Boundary sync the hosts of the host set with the plugin " +
+					"whenever it changes, e.g. the ID of an autoscaling group or a `timestamp()` kept in a " +
+					"`terraform_data` resource. The sync happens on the next run of the controller's sync job.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			AttributesJsonKey: {
 				Description: `The attributes for the host set. Either values encoded with the "jsonencode" function, pre-escaped JSON string, or a file:// or env:// path. Set to a string "null" or remove the block to clear all attributes in the host set.`,
 				Type:        schema.TypeString,
@@ -107,6 +138,25 @@ func resourceHostSetPlugin() *schema.Resource {
 	}
 }
 
+// validatePreferredEndpoint checks that a preferred endpoint is a "cidr:" or
+// "dns:" filter, the only two the controller accepts.
+func validatePreferredEndpoint(i interface{}, k string) ([]string, []error) {
+	v := i.(string)
+	switch {
+	case strings.HasPrefix(v, "cidr:"):
+		if _, _, err := net.ParseCIDR(strings.TrimPrefix(v, "cidr:")); err != nil {
+			return nil, []error{fmt.Errorf("%q contains an invalid CIDR block %q: %w", k, v, err)}
+		}
+	case strings.HasPrefix(v, "dns:"):
+		if strings.TrimPrefix(v, "dns:") == "" {
+			return nil, []error{fmt.Errorf("%q contains a dns filter without a host name", k)}
+		}
+	default:
+		return nil, []error{fmt.Errorf(`%q entries must start with "cidr:" or "dns:", got %q`, k, v)}
+	}
+	return nil, nil
+}
+
 func setFromHostSetPluginResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
 	if err := d.Set(NameKey, raw[NameKey]); err != nil {
 		return err
@@ -126,6 +176,9 @@ func setFromHostSetPluginResponseMap(d *schema.ResourceData, raw map[string]inte
 	if err := d.Set(PreferredEndpointsKey, raw[PreferredEndpointsKey]); err != nil {
 		return err
 	}
+	if err := d.Set(hostSetHostIdsKey, raw["host_ids"]); err != nil {
+		return err
+	}
 	// Attributes stuff
 	{
 		attrRaw, ok := raw["attributes"]
@@ -305,7 +358,9 @@ func resourceHostSetPluginUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(AttributesJsonKey) {
+	// The controller syncs a host set again whenever its attributes are
+	// updated, even to the same value, so that's how a refresh is requested
+	if d.HasChanges(AttributesJsonKey, hostSetPluginRefreshTriggerKey) {
 		attrsVal, ok := d.GetOk(AttributesJsonKey)
 		if ok {
 			attrsStr, err := parseutil.ParsePath(attrsVal.(string))
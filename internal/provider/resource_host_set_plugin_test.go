@@ -99,6 +99,8 @@ func TestAccHostSetPlugin(t *testing.T) {
 					resource.TestCheckResourceAttr(fooSetName, NameKey, "test"),
 					resource.TestCheckResourceAttr(fooSetName, DescriptionKey, "test hostset"),
 					resource.TestCheckResourceAttr(fooSetName, SyncIntervalSecondsKey, fmt.Sprintf("%d", initialSyncIntervalSeconds)),
+					// Syncing is disabled, so no hosts are discovered
+					resource.TestCheckResourceAttr(fooSetName, hostSetHostIdsKey+".#", "0"),
 					testAccCheckHostSetPluginPreferredEndpoints(t, provider, fooSetName, initialPreferredEndpoints),
 				),
 				ExpectNonEmptyPlan: true,
@@ -173,6 +175,77 @@ func TestAccHostSetPlugin(t *testing.T) {
 	})
 }
 
+func TestAccHostSetPluginRefreshTrigger(t *testing.T) {
+	t.Parallel()
+
+	hostSetBlock := `
+	resource "boundary_host_catalog_plugin" "foo" {
+		scope_id    = boundary_scope.proj1.id
+		depends_on  = [boundary_role.proj1_admin]
+		plugin_name = "loopback"
+	}
+
+	resource "boundary_host_set_plugin" "foo" {
+		host_catalog_id = boundary_host_catalog_plugin.foo.id
+		attributes_json = jsonencode({
+			foo = "bar"
+		})
+		refresh_trigger = "%s"
+	}`
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+	fooSetName := "boundary_host_set_plugin.foo"
+
+	var provider *schema.Provider
+	var version uint32
+	checkVersion := func(updated bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			rs, ok := s.RootModule().Resources[fooSetName]
+			if !ok {
+				return fmt.Errorf("Not found: %s", fooSetName)
+			}
+			md := provider.Meta().(*metaData)
+			hsrr, err := hostsets.NewClient(md.client).Read(context.Background(), rs.Primary.ID)
+			if err != nil {
+				return fmt.Errorf("Got an error when reading hostset %q: %v", rs.Primary.ID, err)
+			}
+			if updated && hsrr.Item.Version <= version {
+				return fmt.Errorf("expected the host set to be updated, version is still %d", hsrr.Item.Version)
+			}
+			version = hsrr.Item.Version
+			return nil
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckHostSetPluginResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostSetBlock, "1")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fooSetName, hostSetPluginRefreshTriggerKey, "1"),
+					checkVersion(false),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			importStep(fooSetName, hostSetPluginRefreshTriggerKey),
+			{
+				// Changing the trigger re-sends the unchanged attributes
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostSetBlock, "2")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fooSetName, hostSetPluginRefreshTriggerKey, "2"),
+					resource.TestCheckResourceAttr(fooSetName, AttributesJsonKey, `{"foo":"bar"}`),
+					checkVersion(true),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			importStep(fooSetName, hostSetPluginRefreshTriggerKey),
+		},
+	})
+}
+
 func testAccCheckHostSetPluginResourceExists(testProvider *schema.Provider, name string, expAttrs expectedAttributesState) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -312,3 +385,29 @@ func testAccCheckHostSetPluginPreferredEndpoints(t *testing.T, testProvider *sch
 		return nil
 	}
 }
+
+func TestValidatePreferredEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		wantErr  string
+	}{
+		{endpoint: "cidr:10.0.0.0/8"},
+		{endpoint: "cidr:2001:db8::/32"},
+		{endpoint: "dns:*.example.com"},
+		{endpoint: "cidr:10.0.0.300/8", wantErr: "invalid CIDR block"},
+		{endpoint: "cidr:10.0.0.1", wantErr: "invalid CIDR block"},
+		{endpoint: "dns:", wantErr: "without a host name"},
+		{endpoint: "10.0.0.0/8", wantErr: `must start with "cidr:" or "dns:"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			_, errs := validatePreferredEndpoint(tt.endpoint, PreferredEndpointsKey)
+			if tt.wantErr != "" {
+				require.Len(t, errs, 1)
+				assert.ErrorContains(t, errs[0], tt.wantErr)
+				return
+			}
+			assert.Empty(t, errs)
+		})
+	}
+}
@@ -30,6 +30,12 @@ func resourceHostSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "host-sets",
+			parentKey:        HostCatalogIdKey,
+			parentCollection: "host-catalogs",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -82,6 +88,12 @@ func resourceHostSetStatic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "host-sets",
+			parentKey:        HostCatalogIdKey,
+			parentCollection: "host-catalogs",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
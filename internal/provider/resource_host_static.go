@@ -5,7 +5,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hosts"
@@ -30,6 +34,12 @@ func resourceHost() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "hosts",
+			parentKey:        HostCatalogIdKey,
+			parentCollection: "host-catalogs",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -58,9 +68,11 @@ func resourceHost() *schema.Resource {
 				Required: true,
 			},
 			hostAddressKey: {
-				Description: "The static address of the host resource as `<IP>` (note: port assignment occurs in the target resource definition, do not add :port here) or a domain name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The static address of the host resource as an IPv4 address, an IPv6 address optionally enclosed in brackets, or a DNS name (note: port assignment occurs in the target resource definition, do not add :port here). IPv6 addresses are normalized to their canonical form.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateHostAddress,
+				DiffSuppressFunc: suppressEquivalentHostAddress,
 			},
 		},
 	}
@@ -79,6 +91,12 @@ func resourceHostStatic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "hosts",
+			parentKey:        HostCatalogIdKey,
+			parentCollection: "host-catalogs",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -108,14 +126,63 @@ func resourceHostStatic() *schema.Resource {
 				Required: true,
 			},
 			hostAddressKey: {
-				Description: "The static address of the host resource as `<IP>` (note: port assignment occurs in the target resource definition, do not add :port here) or a domain name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The static address of the host resource as an IPv4 address, an IPv6 address optionally enclosed in brackets, or a DNS name (note: port assignment occurs in the target resource definition, do not add :port here). IPv6 addresses are normalized to their canonical form.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateHostAddress,
+				DiffSuppressFunc: suppressEquivalentHostAddress,
 			},
 		},
 	}
 }
 
+var hostDnsNameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.?$`)
+
+// normalizeHostAddress returns the address the controller stores for a static
+// host: IP addresses in their canonical form, without the brackets around an
+// IPv6 address.
+func normalizeHostAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if strings.HasPrefix(addr, "[") && strings.HasSuffix(addr, "]") {
+		addr = addr[1 : len(addr)-1]
+	}
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip.String()
+	}
+	return addr
+}
+
+// validateHostAddress checks that the address of a static host is an IP
+// address or a DNS name, without a port.
+func validateHostAddress(i interface{}, k string) ([]string, []error) {
+	v := i.(string)
+	if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") {
+		if ip := net.ParseIP(v[1 : len(v)-1]); ip == nil || ip.To4() != nil {
+			return nil, []error{fmt.Errorf("%q can only enclose IPv6 addresses in brackets, got %q", k, v)}
+		}
+		return nil, nil
+	}
+	if net.ParseIP(v) != nil {
+		return nil, nil
+	}
+	if _, _, err := net.SplitHostPort(v); err == nil {
+		return nil, []error{fmt.Errorf("%q must not contain a port, set it on the target instead, got %q", k, v)}
+	}
+	if len(v) > 253 || !hostDnsNameRegexp.MatchString(v) {
+		return nil, []error{fmt.Errorf("%q must be an IP address or a DNS name, got %q", k, v)}
+	}
+	// A top-level domain is never numeric, this is a malformed IPv4 address
+	labels := strings.Split(strings.TrimSuffix(v, "."), ".")
+	if strings.Trim(labels[len(labels)-1], "0123456789") == "" {
+		return nil, []error{fmt.Errorf("%q contains an invalid IP address %q", k, v)}
+	}
+	return nil, nil
+}
+
+func suppressEquivalentHostAddress(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeHostAddress(old) == normalizeHostAddress(new)
+}
+
 func setFromHostResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
 	if err := d.Set(NameKey, raw["name"]); err != nil {
 		return err
@@ -173,7 +240,7 @@ func resourceHostStaticCreate(ctx context.Context, d *schema.ResourceData, meta
 	// it's not allowed
 	case hostTypeStatic:
 		if address != nil {
-			opts = append(opts, hosts.WithStaticHostAddress(*address))
+			opts = append(opts, hosts.WithStaticHostAddress(normalizeHostAddress(*address)))
 		} else {
 			return diag.Errorf("no address provided")
 		}
@@ -271,7 +338,7 @@ func resourceHostStaticUpdate(ctx context.Context, d *schema.ResourceData, meta
 			if ok {
 				addrStr := addrVal.(string)
 				address = &addrStr
-				opts = append(opts, hosts.WithStaticHostAddress(addrStr))
+				opts = append(opts, hosts.WithStaticHostAddress(normalizeHostAddress(addrStr)))
 			}
 		default:
 			return diag.Errorf("address cannot be used with this type of host")
@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccHost(t *testing.T) {
@@ -104,6 +106,54 @@ func testAccHost(t *testing.T, static bool) {
 	})
 }
 
+func TestValidateHostAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		wantErr string
+	}{
+		{address: "10.0.0.1"},
+		{address: "2001:db8::1"},
+		{address: "[2001:db8::1]"},
+		{address: "host.example.com"},
+		{address: "localhost"},
+		{address: "[10.0.0.1]", wantErr: "can only enclose IPv6 addresses in brackets"},
+		{address: "10.0.0.1:22", wantErr: "must not contain a port"},
+		{address: "[2001:db8::1]:22", wantErr: "must not contain a port"},
+		{address: "host.example.com:22", wantErr: "must not contain a port"},
+		{address: "10.0.0.300", wantErr: "invalid IP address"},
+		{address: "-host.example.com", wantErr: "must be an IP address or a DNS name"},
+		{address: "host_name", wantErr: "must be an IP address or a DNS name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			_, errs := validateHostAddress(tt.address, hostAddressKey)
+			if tt.wantErr != "" {
+				require.Len(t, errs, 1)
+				assert.ErrorContains(t, errs[0], tt.wantErr)
+				return
+			}
+			assert.Empty(t, errs)
+		})
+	}
+}
+
+func TestNormalizeHostAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{address: "10.0.0.1", want: "10.0.0.1"},
+		{address: "[2001:DB8:0::1]", want: "2001:db8::1"},
+		{address: "2001:0db8::0001", want: "2001:db8::1"},
+		{address: "host.example.com", want: "host.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeHostAddress(tt.address))
+		})
+	}
+}
+
 func testAccCheckHostResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
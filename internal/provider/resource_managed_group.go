@@ -28,6 +28,12 @@ func resourceManagedGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "managed-groups",
+			parentKey:        AuthMethodIdKey,
+			parentCollection: "auth-methods",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
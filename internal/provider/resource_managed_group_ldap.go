@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	managedGroupLdapGroupNamesKey = "group_names"
+	managedGroupMemberIdsKey      = "member_ids"
+)
+
+func resourceManagedGroupLdap() *schema.Resource {
+	return &schema.Resource{
+		Description: "The LDAP managed group resource allows you to configure a Boundary managed group for an " +
+			"LDAP auth method. The accounts of users that are members of one of the LDAP groups become members " +
+			"of the managed group when they log in, so the managed group can be used as a role principal.",
+
+		CreateContext: resourceManagedGroupLdapCreate,
+		ReadContext:   resourceManagedGroupLdapRead,
+		UpdateContext: resourceManagedGroupLdapUpdate,
+		DeleteContext: resourceManagedGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			requireControllerVersion("0.13.0", "boundary_managed_group_ldap", nil),
+			checkPermissions(permissionCheck{
+				collection:       "managed-groups",
+				parentKey:        AuthMethodIdKey,
+				parentCollection: "auth-methods",
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The managed group name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The managed group description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			AuthMethodIdKey: {
+				Description: "The resource ID for the auth method.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			managedGroupLdapGroupNamesKey: {
+				Description: "The names of the LDAP groups whose members are members of the managed group.",
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MinItems:    1,
+			},
+			managedGroupMemberIdsKey: {
+				Description: "The IDs of the accounts that are members of the managed group.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func setFromManagedGroupLdapResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	if err := d.Set(NameKey, raw[NameKey]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw[DescriptionKey]); err != nil {
+		return err
+	}
+	if err := d.Set(AuthMethodIdKey, raw[AuthMethodIdKey]); err != nil {
+		return err
+	}
+	if err := d.Set(managedGroupMemberIdsKey, raw[managedGroupMemberIdsKey]); err != nil {
+		return err
+	}
+
+	attrs, _ := raw["attributes"].(map[string]interface{})
+	if err := d.Set(managedGroupLdapGroupNamesKey, attrs[managedGroupLdapGroupNamesKey]); err != nil {
+		return err
+	}
+
+	d.SetId(raw[IDKey].(string))
+
+	return nil
+}
+
+func resourceManagedGroupLdapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grpClient := managedgroups.NewClient(md.client)
+
+	authMethodVal, ok := d.GetOk(AuthMethodIdKey)
+	if !ok {
+		return diag.Errorf("no auth method ID provided")
+	}
+
+	opts := []managedgroups.Option{
+		managedgroups.WithLdapManagedGroupGroupNames(expandStringList(d.Get(managedGroupLdapGroupNamesKey).([]interface{}))),
+	}
+	if v, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, managedgroups.WithName(v.(string)))
+	}
+	if v, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, managedgroups.WithDescription(v.(string)))
+	}
+
+	grp, err := grpClient.Create(ctx, authMethodVal.(string), opts...)
+	if err != nil {
+		return diag.Errorf("error creating managed group: %v", err)
+	}
+	if grp == nil {
+		return diag.Errorf("managed group nil after create")
+	}
+
+	if err := setFromManagedGroupLdapResponseMap(d, grp.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceManagedGroupLdapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grpClient := managedgroups.NewClient(md.client)
+
+	grp, err := grpClient.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading managed group: %v", err)
+	}
+	if grp == nil {
+		return diag.Errorf("managed group nil after read")
+	}
+
+	if err := setFromManagedGroupLdapResponseMap(d, grp.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceManagedGroupLdapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grpClient := managedgroups.NewClient(md.client)
+
+	var opts []managedgroups.Option
+	if d.HasChange(NameKey) {
+		opts = append(opts, managedgroups.DefaultName())
+		if v, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, managedgroups.WithName(v.(string)))
+		}
+	}
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, managedgroups.DefaultDescription())
+		if v, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, managedgroups.WithDescription(v.(string)))
+		}
+	}
+	if d.HasChange(managedGroupLdapGroupNamesKey) {
+		opts = append(opts, managedgroups.WithLdapManagedGroupGroupNames(expandStringList(d.Get(managedGroupLdapGroupNamesKey).([]interface{}))))
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, managedgroups.WithAutomaticVersioning(true))
+		grp, err := grpClient.Update(ctx, d.Id(), 0, opts...)
+		if err != nil {
+			return diag.Errorf("error updating managed group: %v", err)
+		}
+		if grp == nil {
+			return diag.Errorf("managed group nil after update")
+		}
+
+		if err := setFromManagedGroupLdapResponseMap(d, grp.GetResponse().Map); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fooManagedGroupLdap = fmt.Sprintf(`
+resource "boundary_managed_group_ldap" "foo" {
+	name           = "%s"
+	description    = "%s"
+	auth_method_id = boundary_auth_method_ldap.foo.id
+	group_names    = ["admin"]
+}
+
+resource "boundary_role" "foo" {
+	name          = "ldap admins"
+	scope_id      = boundary_scope.org1.id
+	principal_ids = [boundary_managed_group_ldap.foo.id]
+	depends_on    = [boundary_role.org1_admin]
+}`, managedGroupName, managedGroupDescription)
+
+	fooManagedGroupLdapUpdate = fmt.Sprintf(`
+resource "boundary_managed_group_ldap" "foo" {
+	name           = "%s"
+	description    = "%s"
+	auth_method_id = boundary_auth_method_ldap.foo.id
+	group_names    = ["admin", "operators"]
+}`, managedGroupName+managedGroupUpdate, managedGroupDescription+managedGroupUpdate)
+)
+
+func TestAccManagedGroupLdap(t *testing.T) {
+	skipForTestControllerVersion(t, "0.13.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckManagedGroupResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, fooAuthMethodLdap, fooManagedGroupLdap),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedGroupResourceExists(provider, "boundary_managed_group_ldap.foo"),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", DescriptionKey, managedGroupDescription),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", NameKey, managedGroupName),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", "group_names.#", "1"),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", "group_names.0", "admin"),
+					resource.TestCheckResourceAttrPair("boundary_role.foo", "principal_ids.0", "boundary_managed_group_ldap.foo", "id"),
+				),
+			},
+			importStep("boundary_managed_group_ldap.foo"),
+			{
+				// test update
+				Config: testConfig(url, fooOrg, fooAuthMethodLdap, fooManagedGroupLdapUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedGroupResourceExists(provider, "boundary_managed_group_ldap.foo"),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", DescriptionKey, managedGroupDescription+managedGroupUpdate),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", NameKey, managedGroupName+managedGroupUpdate),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", "group_names.#", "2"),
+					resource.TestCheckResourceAttr("boundary_managed_group_ldap.foo", "group_names.1", "operators"),
+				),
+			},
+			importStep("boundary_managed_group_ldap.foo"),
+		},
+	})
+}
+
+func TestResourceManagedGroupLdapCreate(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/managed-groups", &body, map[string]interface{}{
+		"id":             "mgldap_1234567890",
+		"auth_method_id": "amldap_1234567890",
+		"type":           "ldap",
+		"name":           managedGroupName,
+		"member_ids":     []interface{}{"acctldap_1234567890"},
+		"attributes": map[string]interface{}{
+			"group_names": []interface{}{"admin", "operators"},
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceManagedGroupLdap().Schema, map[string]interface{}{
+		NameKey:                       managedGroupName,
+		AuthMethodIdKey:               "amldap_1234567890",
+		managedGroupLdapGroupNamesKey: []interface{}{"admin", "operators"},
+	})
+	require.False(t, resourceManagedGroupLdapCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "amldap_1234567890", body["auth_method_id"])
+	assert.Equal(t, map[string]interface{}{"group_names": []interface{}{"admin", "operators"}}, body["attributes"])
+
+	assert.Equal(t, "mgldap_1234567890", d.Id())
+	assert.Equal(t, []interface{}{"admin", "operators"}, d.Get(managedGroupLdapGroupNamesKey))
+	assert.Equal(t, []interface{}{"acctldap_1234567890"}, d.Get(managedGroupMemberIdsKey).(*schema.Set).List())
+}
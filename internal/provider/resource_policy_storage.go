@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	policyTypeStorage = "storage"
+
+	policyStorageRetainForDaysKey          = "retain_for_days"
+	policyStorageRetainForOverridableKey   = "retain_for_overridable"
+	policyStorageDeleteAfterDaysKey        = "delete_after_days"
+	policyStorageDeleteAfterOverridableKey = "delete_after_overridable"
+)
+
+func resourcePolicyStorage() *schema.Resource {
+	return &schema.Resource{
+		Description: "The storage policy resource allows you to configure a Boundary storage policy, which " +
+			"controls how long the session recordings of a scope are kept. Storage policies are always part " +
+			"of the global scope or an org and only take effect once attached to a scope, see " +
+			"`boundary_scope_policy_attachment`.",
+
+		CreateContext: resourcePolicyStorageCreate,
+		ReadContext:   resourcePolicyStorageRead,
+		UpdateContext: resourcePolicyStorageUpdate,
+		DeleteContext: resourcePolicyStorageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			requireControllerVersion("0.15.0", "boundary_policy_storage", nil),
+			checkPermissions(permissionCheck{
+				collection:       "policies",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the storage policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The storage policy name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The storage policy description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope ID in which the resource is created, either global or an org." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			policyStorageRetainForDaysKey: {
+				Description:  "The number of days session recordings must be kept for. Set to -1 to keep them forever. Defaults to 0, which doesn't require them to be kept.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			policyStorageRetainForOverridableKey: {
+				Description: "Whether the retention period can be overridden by the policy of a child scope. Defaults to true.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			policyStorageDeleteAfterDaysKey: {
+				Description:  "The number of days after which session recordings are deleted. Must be longer than the retention period. Defaults to 0, which doesn't delete them automatically.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			policyStorageDeleteAfterOverridableKey: {
+				Description: "Whether the deletion period can be overridden by the policy of a child scope. Defaults to true.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+// policyStoragePeriod returns the number of days and whether they can be
+// overridden of the retain_for or delete_after period in the attributes of a
+// storage policy.
+func policyStoragePeriod(attrs map[string]interface{}, field string) (int64, bool) {
+	period, _ := attrs[field].(map[string]interface{})
+	var days int64
+	if v, ok := period["days"].(json.Number); ok {
+		days, _ = v.Int64()
+	}
+	// The controller leaves out false values
+	overridable, _ := period["overridable"].(bool)
+	return days, overridable
+}
+
+func setFromPolicyStorageResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	if err := d.Set(NameKey, raw["name"]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
+		return err
+	}
+	if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
+		return err
+	}
+
+	attrs, _ := raw["attributes"].(map[string]interface{})
+	for _, p := range []struct {
+		field, daysKey, overridableKey string
+	}{
+		{"retain_for", policyStorageRetainForDaysKey, policyStorageRetainForOverridableKey},
+		{"delete_after", policyStorageDeleteAfterDaysKey, policyStorageDeleteAfterOverridableKey},
+	} {
+		days, overridable := policyStoragePeriod(attrs, p.field)
+		if err := d.Set(p.daysKey, days); err != nil {
+			return err
+		}
+		if err := d.Set(p.overridableKey, overridable); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(raw["id"].(string))
+	return nil
+}
+
+func policyStorageRetainFor(d *schema.ResourceData) policies.StoragePolicyRetainFor {
+	return policies.StoragePolicyRetainFor{
+		Days:        int32(d.Get(policyStorageRetainForDaysKey).(int)),
+		Overridable: d.Get(policyStorageRetainForOverridableKey).(bool),
+	}
+}
+
+func policyStorageDeleteAfter(d *schema.ResourceData) policies.StoragePolicyDeleteAfter {
+	return policies.StoragePolicyDeleteAfter{
+		Days:        int32(d.Get(policyStorageDeleteAfterDaysKey).(int)),
+		Overridable: d.Get(policyStorageDeleteAfterOverridableKey).(bool),
+	}
+}
+
+func resourcePolicyStorageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	var scopeId string
+	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
+		scopeId = scopeIdVal.(string)
+	} else {
+		return diag.Errorf("no scope ID provided")
+	}
+
+	opts := []policies.Option{
+		policies.WithStoragePolicyRetainFor(policyStorageRetainFor(d)),
+		policies.WithStoragePolicyDeleteAfter(policyStorageDeleteAfter(d)),
+	}
+	if nameVal, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, policies.WithName(nameVal.(string)))
+	}
+	if descVal, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, policies.WithDescription(descVal.(string)))
+	}
+
+	pClient := policies.NewClient(md.client)
+
+	pcr, err := pClient.Create(ctx, policyTypeStorage, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error creating storage policy: %v", err)
+	}
+	if pcr == nil {
+		return diag.Errorf("storage policy nil after create")
+	}
+
+	if err := setFromPolicyStorageResponseMap(d, pcr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePolicyStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	pClient := policies.NewClient(md.client)
+
+	prr, err := pClient.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading storage policy: %v", err)
+	}
+	if prr == nil {
+		return diag.Errorf("storage policy nil after read")
+	}
+
+	if err := setFromPolicyStorageResponseMap(d, prr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePolicyStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	pClient := policies.NewClient(md.client)
+
+	opts := []policies.Option{}
+
+	if d.HasChange(NameKey) {
+		opts = append(opts, policies.DefaultName())
+		if nameVal, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, policies.WithName(nameVal.(string)))
+		}
+	}
+
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, policies.DefaultDescription())
+		if descVal, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, policies.WithDescription(descVal.(string)))
+		}
+	}
+
+	if d.HasChanges(policyStorageRetainForDaysKey, policyStorageRetainForOverridableKey) {
+		opts = append(opts, policies.WithStoragePolicyRetainFor(policyStorageRetainFor(d)))
+	}
+
+	if d.HasChanges(policyStorageDeleteAfterDaysKey, policyStorageDeleteAfterOverridableKey) {
+		opts = append(opts, policies.WithStoragePolicyDeleteAfter(policyStorageDeleteAfter(d)))
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, policies.WithAutomaticVersioning(true))
+		pur, err := pClient.Update(ctx, d.Id(), 0, opts...)
+		if err != nil {
+			return diag.Errorf("error updating storage policy: %v", err)
+		}
+		if pur == nil {
+			return diag.Errorf("storage policy nil after update")
+		}
+
+		if err := setFromPolicyStorageResponseMap(d, pur.GetResponse().Map); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePolicyStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	pClient := policies.NewClient(md.client)
+
+	_, err := pClient.Delete(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error deleting storage policy: %v", err)
+	}
+
+	return nil
+}
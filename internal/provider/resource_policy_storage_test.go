@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/policies"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	orgStoragePolicy = `
+resource "boundary_policy_storage" "foo" {
+	name                   = "foo"
+	description            = "keep recordings for a month"
+	scope_id               = boundary_scope.org1.id
+	retain_for_days        = 30
+	retain_for_overridable = false
+	delete_after_days      = 60
+	depends_on             = [boundary_role.org1_admin]
+}`
+
+	orgStoragePolicyUpdate = `
+resource "boundary_policy_storage" "foo" {
+	name            = "foo"
+	description     = "keep recordings forever"
+	scope_id        = boundary_scope.org1.id
+	retain_for_days = -1
+	depends_on      = [boundary_role.org1_admin]
+}`
+)
+
+// Storage policies are only available with session recording, which needs
+// an enterprise controller.
+func TestAccPolicyStorage(t *testing.T) {
+	if os.Getenv("BOUNDARY_TF_PROVIDER_TEST_SESSION_RECORDING") == "" {
+		t.Skip("Not running storage policy test without session recording support")
+	}
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckPolicyStorageResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, orgStoragePolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStorageResourceExists(provider, "boundary_policy_storage.foo"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "retain_for_days", "30"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "retain_for_overridable", "false"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "delete_after_days", "60"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "delete_after_overridable", "true"),
+				),
+			},
+			importStep("boundary_policy_storage.foo"),
+			{
+				// update
+				Config: testConfig(url, fooOrg, orgStoragePolicyUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStorageResourceExists(provider, "boundary_policy_storage.foo"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "description", "keep recordings forever"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "retain_for_days", "-1"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "retain_for_overridable", "true"),
+					resource.TestCheckResourceAttr("boundary_policy_storage.foo", "delete_after_days", "0"),
+				),
+			},
+			importStep("boundary_policy_storage.foo"),
+		},
+	})
+}
+
+func testAccCheckPolicyStorageResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		pClient := policies.NewClient(md.client)
+
+		if _, err := pClient.Read(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading storage policy %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyStorageResourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+
+		for _, rs := range s.RootModule().Resources {
+			switch rs.Type {
+			case "boundary_policy_storage":
+				id := rs.Primary.ID
+				pClient := policies.NewClient(md.client)
+
+				_, err := pClient.Read(context.Background(), id)
+				if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("didn't get a 404 when reading destroyed storage policy %q: %v", id, err)
+				}
+
+			default:
+				continue
+			}
+		}
+		return nil
+	}
+}
+
+func TestResourcePolicyStorageRead(t *testing.T) {
+	md := testApiMetaData(t, testApiItem(t, http.MethodGet, "/v1/policies/pst_1234567890", nil, map[string]interface{}{
+		"id":       "pst_1234567890",
+		"scope_id": "o_1234567890",
+		"type":     "storage",
+		"name":     "foo",
+		"attributes": map[string]interface{}{
+			"retain_for": map[string]interface{}{
+				"days":        30,
+				"overridable": true,
+			},
+			"delete_after": map[string]interface{}{
+				"days": 60,
+			},
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourcePolicyStorage().Schema, map[string]interface{}{})
+	d.SetId("pst_1234567890")
+	require.False(t, resourcePolicyStorageRead(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "pst_1234567890", d.Id())
+	assert.Equal(t, 30, d.Get(policyStorageRetainForDaysKey))
+	assert.Equal(t, true, d.Get(policyStorageRetainForOverridableKey))
+	assert.Equal(t, 60, d.Get(policyStorageDeleteAfterDaysKey))
+	// The controller leaves out false values
+	assert.Equal(t, false, d.Get(policyStorageDeleteAfterOverridableKey))
+}
@@ -5,18 +5,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
-	roleGrantScopeIdKey = "grant_scope_id"
-	rolePrincipalIdsKey = "principal_ids"
-	roleGrantStringsKey = "grant_strings"
+	roleGrantScopeIdKey  = "grant_scope_id"
+	roleGrantScopeIdsKey = "grant_scope_ids"
+	rolePrincipalIdsKey  = "principal_ids"
+	roleGrantStringsKey  = "grant_strings"
+
+	roleGrantScopeThis        = "this"
+	roleGrantScopeChildren    = "children"
+	roleGrantScopeDescendants = "descendants"
 )
 
 func resourceRole() *schema.Resource {
@@ -30,6 +37,19 @@ func resourceRole() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			requireControllerVersion("0.15.0", `The "grant_scope_ids" of roles`, func(d *schema.ResourceDiff) bool {
+				return d.NewValueKnown(roleGrantScopeIdsKey) && d.Get(roleGrantScopeIdsKey).(*schema.Set).Len() > 0
+			}),
+			customizeDiffRoleGrantScopeIds,
+			checkPermissions(permissionCheck{
+				collection:       "roles",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -48,9 +68,10 @@ func resourceRole() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Description: "The scope ID in which the resource is created." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			rolePrincipalIdsKey: {
@@ -66,9 +87,22 @@ func resourceRole() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			roleGrantScopeIdKey: {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Description:   "The scope the grants of the role apply to.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "Use grant_scope_ids instead, grant_scope_id is deprecated since Boundary 0.15.0.",
+				ConflictsWith: []string{roleGrantScopeIdsKey},
+			},
+			roleGrantScopeIdsKey: {
+				Description: "The scopes the grants of the role apply to. Each entry is either a scope ID or one of " +
+					"the keywords `this`, for the scope of the role, `children`, for the direct children of the scope " +
+					"of the role, or `descendants`, for all the scopes below the global scope. Defaults to `this`.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{roleGrantScopeIdKey},
 			},
 		},
 	}
@@ -93,6 +127,9 @@ func setFromRoleResponseMap(d *schema.ResourceData, raw map[string]interface{})
 	if err := d.Set(roleGrantScopeIdKey, raw["grant_scope_id"]); err != nil {
 		return err
 	}
+	if err := d.Set(roleGrantScopeIdsKey, raw["grant_scope_ids"]); err != nil {
+		return err
+	}
 	d.SetId(raw["id"].(string))
 	return nil
 }
@@ -145,6 +182,15 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	var grantScopeIds []string
+	if grantScopeIdsVal, ok := d.GetOk(roleGrantScopeIdsKey); ok {
+		list := grantScopeIdsVal.(*schema.Set).List()
+		grantScopeIds = make([]string, 0, len(list))
+		for _, i := range list {
+			grantScopeIds = append(grantScopeIds, i.(string))
+		}
+	}
+
 	rc := roles.NewClient(md.client)
 
 	tcr, err := rc.Create(ctx, scopeId, opts...)
@@ -173,6 +219,18 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if grantScopeIds != nil {
+		tsgsr, err := rc.SetGrantScopes(ctx, tcr.Item.Id, 0, grantScopeIds, roles.WithAutomaticVersioning(true))
+		switch {
+		case err != nil:
+			errs = append(errs, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grant scopes", Detail: err.Error()})
+		case tsgsr == nil:
+			errs = append(errs, diag.Diagnostic{Severity: diag.Error, Summary: "nil role after setting grant scopes"})
+		default:
+			apiResponse = tsgsr.GetResponse().Map
+		}
+	}
+
 	if grantStrings != nil {
 		tsgr, err := rc.SetGrants(ctx, tcr.Item.Id, 0, grantStrings, roles.WithAutomaticVersioning(true))
 		switch {
@@ -275,6 +333,35 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	var diags diag.Diagnostics
+	// Grant scopes are removed before others are added, since the controller
+	// rejects e.g. "children" alongside "descendants" even for a moment
+	if d.HasChange(roleGrantScopeIdsKey) {
+		oldVal, newVal := d.GetChange(roleGrantScopeIdsKey)
+		oldSet, newSet := oldVal.(*schema.Set), newVal.(*schema.Set)
+		var removed, added []string
+		for _, i := range oldSet.Difference(newSet).List() {
+			removed = append(removed, i.(string))
+		}
+		for _, i := range newSet.Difference(oldSet).List() {
+			added = append(added, i.(string))
+		}
+
+		var err error
+		if len(removed) > 0 {
+			_, err = rc.RemoveGrantScopes(ctx, d.Id(), 0, removed, roles.WithAutomaticVersioning(true))
+		}
+		if err == nil && len(added) > 0 {
+			_, err = rc.AddGrantScopes(ctx, d.Id(), 0, added, roles.WithAutomaticVersioning(true))
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grant scopes", Detail: err.Error()})
+		} else {
+			if err := d.Set(roleGrantScopeIdsKey, newSet); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if d.HasChange(roleGrantStringsKey) {
 		var grantStrings []string
 		if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
@@ -314,6 +401,20 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// customizeDiffRoleGrantScopeIds checks the "grant_scope_ids" of a role at plan
+// time. The "children" and "descendants" keywords overlap, so only one of them
+// can be used.
+func customizeDiffRoleGrantScopeIds(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(roleGrantScopeIdsKey) {
+		return nil
+	}
+	grantScopeIds := d.Get(roleGrantScopeIdsKey).(*schema.Set)
+	if grantScopeIds.Contains(roleGrantScopeChildren) && grantScopeIds.Contains(roleGrantScopeDescendants) {
+		return fmt.Errorf("%q can't contain both %q and %q", roleGrantScopeIdsKey, roleGrantScopeChildren, roleGrantScopeDescendants)
+	}
+	return nil
+}
+
 func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 	rc := roles.NewClient(md.client)
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	roleIdKey          = "role_id"
+	roleGrantStringKey = "grant_string"
+)
+
+func resourceRoleGrant() *schema.Resource {
+	return &schema.Resource{
+		Description: "The role grant resource adds a single grant to an existing role, leaving the other " +
+			"grants of the role alone, so several configurations can contribute grants to the same role. " +
+			"The `boundary_role` resource managing the role should not set `grant_strings`, and should " +
+			"ignore changes to them with a `lifecycle` block.",
+
+		CreateContext: resourceRoleGrantCreate,
+		ReadContext:   resourceRoleGrantRead,
+		DeleteContext: resourceRoleGrantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			parentKey:        roleIdKey,
+			parentCollection: "roles",
+			parentAction:     "add-grants",
+		}),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the role grant, in the form `<role_id>:<grant_string>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			roleIdKey: {
+				Description: "The ID of the role to add the grant to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			roleGrantStringKey: {
+				Description: "The grant to add to the role, e.g. `ids=*;type=*;actions=read`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// parseRoleGrantId splits the ID of a role grant into the ID of the role and
+// the grant. Role IDs never contain a colon while grants may, e.g. in the
+// "read:self" action, so the ID is split at the first one.
+func parseRoleGrantId(id string) (string, string, error) {
+	roleId, grant, ok := strings.Cut(id, ":")
+	if !ok || roleId == "" || grant == "" {
+		return "", "", fmt.Errorf("invalid role grant ID %q, expected <role_id>:<grant_string>", id)
+	}
+	return roleId, grant, nil
+}
+
+// normalizeGrant returns the grant in a form that is the same for all the
+// ways of writing it the controller accepts: "id" is the older name of "ids",
+// and neither the order of the fields nor of the values of a field matter.
+// The controller stores grants in its own canonical form, so a grant has to
+// be compared in this form to be found on the role again.
+func normalizeGrant(grant string) string {
+	grant = strings.TrimSpace(grant)
+	if strings.HasPrefix(grant, "{") {
+		// Grants in the JSON format are left alone
+		return grant
+	}
+	segments := strings.Split(grant, ";")
+	for i, segment := range segments {
+		k, v, ok := strings.Cut(strings.TrimSpace(segment), "=")
+		if !ok {
+			continue
+		}
+		if k == "id" {
+			k = "ids"
+		}
+		values := strings.Split(v, ",")
+		sort.Strings(values)
+		segments[i] = k + "=" + strings.Join(values, ",")
+	}
+	sort.Strings(segments)
+	return strings.Join(segments, ";")
+}
+
+// findRoleGrant returns the grant of the role matching the given one, in the
+// form the controller returned it.
+func findRoleGrant(grants []string, grant string) (string, bool) {
+	want := normalizeGrant(grant)
+	for _, g := range grants {
+		if normalizeGrant(g) == want {
+			return g, true
+		}
+	}
+	return "", false
+}
+
+func resourceRoleGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grant := d.Get(roleGrantStringKey).(string)
+	defer md.locks.lock(roleId)()
+	_, err := rc.AddGrants(ctx, roleId, 0, []string{grant}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding grant to role: %v", err)
+	}
+
+	d.SetId(roleId + ":" + grant)
+	return nil
+}
+
+func resourceRoleGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId, grant, err := parseRoleGrantId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrr, err := rc.Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read role: %v", err)
+	}
+	if rrr == nil {
+		return diag.Errorf("role nil after read")
+	}
+
+	if _, found := findRoleGrant(rrr.Item.GrantStrings, grant); !found {
+		// The grant was removed outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(roleIdKey, roleId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(roleGrantStringKey, grant); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRoleGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grant := d.Get(roleGrantStringKey).(string)
+	defer md.locks.lock(roleId)()
+
+	// Remove the grant in the form the controller stored it in
+	rrr, err := rc.Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error calling read role: %v", err)
+	}
+	if rrr == nil {
+		return diag.Errorf("role nil after read")
+	}
+	stored, found := findRoleGrant(rrr.Item.GrantStrings, grant)
+	if !found {
+		// The grant was already removed outside of Terraform
+		return nil
+	}
+
+	_, err = rc.RemoveGrants(ctx, roleId, 0, []string{stored}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing grant from role: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	roleGrantSelf = "ids=*;type=account;actions=read:self"
+)
+
+var (
+	sharedRole = `
+resource "boundary_role" "shared" {
+	name       = "shared"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+
+	lifecycle {
+		ignore_changes = [grant_strings]
+	}
+}`
+
+	sharedRoleGrants = fmt.Sprintf(`
+resource "boundary_role_grant" "read" {
+	role_id      = boundary_role.shared.id
+	grant_string = "%s"
+}
+
+resource "boundary_role_grant" "self" {
+	role_id      = boundary_role.shared.id
+	grant_string = "%s"
+}`, readonlyGrant, roleGrantSelf)
+
+	sharedRoleGrantsUpdate = fmt.Sprintf(`
+resource "boundary_role_grant" "read" {
+	role_id      = boundary_role.shared.id
+	grant_string = "%s"
+}`, readonlyGrant)
+)
+
+func TestAccRoleGrant(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// add two grants to the role
+				Config: testConfig(url, fooOrg, sharedRole, sharedRoleGrants),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleGrants(provider, "boundary_role.shared", []string{readonlyGrant, roleGrantSelf}),
+				),
+			},
+			importStep("boundary_role_grant.read"),
+			importStep("boundary_role_grant.self"),
+			{
+				// remove one of them, leaving the other alone
+				Config: testConfig(url, fooOrg, sharedRole, sharedRoleGrantsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleGrants(provider, "boundary_role.shared", []string{readonlyGrant}),
+				),
+			},
+		},
+	})
+}
+
+func TestFindRoleGrant(t *testing.T) {
+	grants := []string{"ids=*;type=*;actions=read", "ids=hcst_1234567890;actions=authorize-session,read"}
+
+	tests := []struct {
+		name  string
+		grant string
+		want  string
+		found bool
+	}{
+		{name: "same form", grant: "ids=*;type=*;actions=read", want: "ids=*;type=*;actions=read", found: true},
+		{name: "older id field", grant: "id=*;type=*;actions=read", want: "ids=*;type=*;actions=read", found: true},
+		{name: "fields reordered", grant: "type=*;actions=read;ids=*", want: "ids=*;type=*;actions=read", found: true},
+		{name: "actions reordered", grant: "ids=hcst_1234567890;actions=read,authorize-session", want: "ids=hcst_1234567890;actions=authorize-session,read", found: true},
+		{name: "different actions", grant: "ids=*;type=*;actions=read,list"},
+		{name: "different ids", grant: "ids=hcst_0987654321;actions=authorize-session,read"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := findRoleGrant(grants, tt.grant)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// testAccCheckRoleGrants checks that the role has exactly the given grants.
+func testAccCheckRoleGrants(testProvider *schema.Provider, name string, grants []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("role resource not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		rc := roles.NewClient(md.client)
+
+		rrr, err := rc.Read(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading role %q: %v", rs.Primary.ID, err)
+		}
+
+		got := rrr.Item.GrantStrings
+		if len(got) != len(grants) {
+			return fmt.Errorf("expected grants %v on the role, got %v", grants, got)
+		}
+		for _, want := range grants {
+			found := false
+			for _, g := range got {
+				if g == want {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("expected grants %v on the role, got %v", grants, got)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rolePrincipalIdKey = "principal_id"
+)
+
+func resourceRolePrincipal() *schema.Resource {
+	return &schema.Resource{
+		Description: "The role principal resource adds a single principal, a user, group or managed group, to an " +
+			"existing role, leaving the other principals of the role alone. The `boundary_role` resource " +
+			"managing the role should not set `principal_ids`, and should ignore changes to them with a " +
+			"`lifecycle` block.",
+
+		CreateContext: resourceRolePrincipalCreate,
+		ReadContext:   resourceRolePrincipalRead,
+		DeleteContext: resourceRolePrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			parentKey:        roleIdKey,
+			parentCollection: "roles",
+			parentAction:     "add-principals",
+		}),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the role principal, in the form `<role_id>:<principal_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			roleIdKey: {
+				Description: "The ID of the role to add the principal to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			rolePrincipalIdKey: {
+				Description: "The ID of the user, group or managed group to add to the role.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// parseRolePrincipalId splits the ID of a role principal into the ID of the
+// role and the principal.
+func parseRolePrincipalId(id string) (string, string, error) {
+	roleId, principalId, ok := strings.Cut(id, ":")
+	if !ok || roleId == "" || principalId == "" {
+		return "", "", fmt.Errorf("invalid role principal ID %q, expected <role_id>:<principal_id>", id)
+	}
+	return roleId, principalId, nil
+}
+
+func resourceRolePrincipalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	principalId := d.Get(rolePrincipalIdKey).(string)
+	defer md.locks.lock(roleId)()
+	_, err := rc.AddPrincipals(ctx, roleId, 0, []string{principalId}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding principal to role: %v", err)
+	}
+
+	d.SetId(roleId + ":" + principalId)
+	return nil
+}
+
+func resourceRolePrincipalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId, principalId, err := parseRolePrincipalId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrr, err := rc.Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read role: %v", err)
+	}
+	if rrr == nil {
+		return diag.Errorf("role nil after read")
+	}
+
+	found := false
+	for _, p := range rrr.Item.PrincipalIds {
+		if p == principalId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// The principal was removed outside of Terraform, or deleted
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(roleIdKey, roleId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(rolePrincipalIdKey, principalId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRolePrincipalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	defer md.locks.lock(roleId)()
+
+	_, err := rc.RemovePrincipals(ctx, roleId, 0, []string{d.Get(rolePrincipalIdKey).(string)}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing principal from role: %v", err)
+	}
+
+	return nil
+}
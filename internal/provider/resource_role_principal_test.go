@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	sharedPrincipalsRole = `
+resource "boundary_role" "shared" {
+	name       = "shared"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+
+	lifecycle {
+		ignore_changes = [principal_ids]
+	}
+}`
+
+	sharedRolePrincipals = `
+resource "boundary_role_principal" "foo" {
+	role_id      = boundary_role.shared.id
+	principal_id = boundary_user.foo.id
+}
+
+resource "boundary_role_principal" "bar" {
+	role_id      = boundary_role.shared.id
+	principal_id = boundary_user.bar.id
+}`
+
+	sharedRolePrincipalsUpdate = `
+resource "boundary_role_principal" "foo" {
+	role_id      = boundary_role.shared.id
+	principal_id = boundary_user.foo.id
+}`
+)
+
+func TestAccRolePrincipal(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// add two principals to the role
+				Config: testConfig(url, fooOrg, fooUser, barUser, sharedPrincipalsRole, sharedRolePrincipals),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourcePrincipalsSet(provider, "boundary_role.shared", []string{"boundary_user.foo", "boundary_user.bar"}),
+				),
+			},
+			importStep("boundary_role_principal.foo"),
+			importStep("boundary_role_principal.bar"),
+			{
+				// remove one of them, leaving the other alone
+				Config: testConfig(url, fooOrg, fooUser, barUser, sharedPrincipalsRole, sharedRolePrincipalsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourcePrincipalsSet(provider, "boundary_role.shared", []string{"boundary_user.foo"}),
+				),
+			},
+		},
+	})
+}
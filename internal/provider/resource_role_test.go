@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,9 +16,12 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/boundary/testing/controller"
+	goversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -185,6 +189,22 @@ resource "boundary_role" "with_grants" {
 	scope_id      = boundary_scope.proj1.id
 	depends_on    = [boundary_role.proj1_admin]
 }`, readonlyGrant, readonlyGrantUpdate)
+
+	orgRoleWithGrantScopes = `
+resource "boundary_role" "with_grant_scopes" {
+	name            = "with_grant_scopes"
+	scope_id        = boundary_scope.org1.id
+	grant_scope_ids = ["this", "children"]
+	depends_on      = [boundary_role.org1_admin]
+}`
+
+	orgRoleWithGrantScopesUpdate = `
+resource "boundary_role" "with_grant_scopes" {
+	name            = "with_grant_scopes"
+	scope_id        = boundary_scope.org1.id
+	grant_scope_ids = [boundary_scope.proj1.id]
+	depends_on      = [boundary_role.org1_admin]
+}`
 )
 
 func TestAccRoleToOrgToProject(t *testing.T) {
@@ -401,6 +421,120 @@ func TestAccRoleWithGroups(t *testing.T) {
 	})
 }
 
+func TestAccRoleWithGrantScopes(t *testing.T) {
+	skipForTestControllerVersion(t, "0.15.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, orgRoleWithGrantScopes),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.with_grant_scopes"),
+					resource.TestCheckResourceAttr("boundary_role.with_grant_scopes", roleGrantScopeIdsKey+".#", "2"),
+					resource.TestCheckTypeSetElemAttr("boundary_role.with_grant_scopes", roleGrantScopeIdsKey+".*", "this"),
+					resource.TestCheckTypeSetElemAttr("boundary_role.with_grant_scopes", roleGrantScopeIdsKey+".*", "children"),
+				),
+			},
+			importStep("boundary_role.with_grant_scopes"),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, orgRoleWithGrantScopesUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.with_grant_scopes"),
+					resource.TestCheckResourceAttr("boundary_role.with_grant_scopes", roleGrantScopeIdsKey+".#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("boundary_role.with_grant_scopes", roleGrantScopeIdsKey+".*", "boundary_scope.proj1", IDKey),
+				),
+			},
+			importStep("boundary_role.with_grant_scopes"),
+		},
+	})
+}
+
+func TestCustomizeDiffRoleGrantScopeIds(t *testing.T) {
+	tests := []struct {
+		name          string
+		grantScopeIds []interface{}
+		wantErr       string
+	}{
+		{name: "this", grantScopeIds: []interface{}{"this"}},
+		{name: "this-and-descendants", grantScopeIds: []interface{}{"this", "descendants"}},
+		{name: "scope-ids", grantScopeIds: []interface{}{"p_1234567890", "p_0987654321"}},
+		{name: "children-and-descendants", grantScopeIds: []interface{}{"children", "descendants"}, wantErr: `can't contain both "children" and "descendants"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				ScopeIdKey:           "global",
+				roleGrantScopeIdsKey: tt.grantScopeIds,
+			})
+			_, err := resourceRole().Diff(context.Background(), nil, config, &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceRoleCreateGrantScopeIds(t *testing.T) {
+	var requests []string
+	var setBody map[string]interface{}
+	md := testApiMetaData(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		role := map[string]interface{}{
+			"id":       "r_1234567890",
+			"scope_id": "o_1234567890",
+			"version":  1,
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/roles", "GET /v1/roles/r_1234567890":
+		case "POST /v1/roles/r_1234567890:set-grant-scopes":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&setBody))
+			role["version"] = 2
+			role["grant_scope_ids"] = []string{"this", "descendants"}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(role))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceRole().Schema, map[string]interface{}{
+		ScopeIdKey:           "o_1234567890",
+		roleGrantScopeIdsKey: []interface{}{"this", "descendants"},
+	})
+	require.False(t, resourceRoleCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, []string{
+		"POST /v1/roles",
+		"GET /v1/roles/r_1234567890",
+		"POST /v1/roles/r_1234567890:set-grant-scopes",
+	}, requests)
+	assert.ElementsMatch(t, []interface{}{"this", "descendants"}, setBody["grant_scope_ids"])
+	assert.Equal(t, "r_1234567890", d.Id())
+	assert.ElementsMatch(t, []interface{}{"this", "descendants"}, d.Get(roleGrantScopeIdsKey).(*schema.Set).List())
+}
+
+func TestRequireControllerVersionRoleGrantScopeIds(t *testing.T) {
+	md := &metaData{controllerVersion: goversion.Must(goversion.NewVersion("0.14.3"))}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		ScopeIdKey:           "global",
+		roleGrantScopeIdsKey: []interface{}{"this"},
+	})
+	_, err := resourceRole().Diff(context.Background(), nil, config, md)
+	assert.ErrorContains(t, err, `The "grant_scope_ids" of roles requires Boundary 0.15.0 or later`)
+}
+
 // testAccCheckRoleDestroyed checks the terraform state for the host
 // catalog and returns an error if found.
 //
@@ -5,9 +5,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +19,12 @@ const (
 	scopeGlobalScopeKey        = "global_scope"
 	scopeAutoCreateAdminRole   = "auto_create_admin_role"
 	scopeAutoCreateDefaultRole = "auto_create_default_role"
+	scopeAdminRoleIdKey        = "admin_role_id"
+	scopeDefaultRoleIdKey      = "default_role_id"
+
+	// scopeAdminRoleName is the name the controller gives the role it creates
+	// to administer a new scope.
+	scopeAdminRoleName = "Administration"
 )
 
 func resourceScope() *schema.Resource {
@@ -30,6 +38,12 @@ func resourceScope() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "scopes",
+			parentKey:        ScopeIdKey,
+			parentCollection: "scopes",
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -59,15 +73,27 @@ func resourceScope() *schema.Resource {
 				Optional:    true,
 			},
 			scopeAutoCreateAdminRole: {
-				Description: "If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives permissions to manage the scope to the provider's user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
+				Description: "If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives permissions to manage the scope to the provider's user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform, see `admin_role_id` to manage its grants.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
 			scopeAutoCreateDefaultRole: {
-				Description: "Only relevant when creating an org scope. If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives listing of scopes and auth methods and the ability to authenticate to the anonymous user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
+				Description: "Only relevant when creating an org scope. If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives listing of scopes and auth methods and the ability to authenticate to the anonymous user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform, see `default_role_id` to manage its grants.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			scopeAdminRoleIdKey: {
+				Description: "The ID of the role created automatically when `auto_create_admin_role` is set. Its grants can be " +
+					"managed with `boundary_role_grant` resources, or the role can be imported into a `boundary_role` resource.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			scopeDefaultRoleIdKey: {
+				Description: "The ID of the role created automatically when `auto_create_default_role` is set. Its grants can be " +
+					"managed with `boundary_role_grant` resources, or the role can be imported into a `boundary_role` resource.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -153,14 +179,49 @@ func resourceScopeCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	if d.Get(scopeAutoCreateAdminRole).(bool) || d.Get(scopeAutoCreateDefaultRole).(bool) {
+		// The scope exists at this point, so don't fail its creation if e.g.
+		// the grants of the provider's user don't allow to list the roles
+		if err := setScopeAutoCreatedRoleIds(ctx, d, meta); err != nil {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Unable to find the roles created automatically with the scope.",
+				Detail:   err.Error(),
+			}}
+		}
+	}
+
+	return nil
+}
+
+// setScopeAutoCreatedRoleIds looks up the roles the controller created along
+// with a new scope. The controller doesn't return their IDs, but right after
+// the scope is created they are the only roles in it; they can only be told
+// apart by name.
+func setScopeAutoCreatedRoleIds(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	rlr, err := rc.List(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing roles created with the scope: %w", err)
+	}
+	for _, r := range rlr.Items {
+		key := scopeDefaultRoleIdKey
+		if r.Name == scopeAdminRoleName {
+			key = scopeAdminRoleIdKey
+		}
+		if err := d.Set(key, r.Id); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func resourceScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
-	scp := scopes.NewClient(md.client)
 
-	srr, err := scp.Read(ctx, d.Id())
+	raw, err := md.readScope(ctx, d.Id())
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
 			d.SetId("")
@@ -168,11 +229,11 @@ func resourceScopeRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 		return diag.Errorf("error calling read scope: %v", err)
 	}
-	if srr == nil {
+	if raw == nil {
 		return diag.Errorf("scope nil after read")
 	}
 
-	if err := setFromScopeResponseMap(d, srr.GetResponse().Map); err != nil {
+	if err := setFromScopeResponseMap(d, raw); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -210,6 +271,7 @@ func resourceScopeUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if len(opts) > 0 {
 		opts = append(opts, scopes.WithAutomaticVersioning(true))
 		_, err := scp.Update(ctx, d.Id(), 0, opts...)
+		md.cache.invalidate(d.Id())
 		if err != nil {
 			return diag.Errorf("error updating scope: %v", err)
 		}
@@ -238,6 +300,7 @@ func resourceScopeDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	scp := scopes.NewClient(md.client)
 
 	_, err := scp.Delete(ctx, d.Id())
+	md.cache.invalidate(d.Id())
 	if err != nil {
 		return diag.Errorf("error deleting scope: %v", err)
 	}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	scopeGlobalId                  = "global"
+	scopeGlobalPrimaryAuthMethodId = "primary_auth_method_id"
+)
+
+func resourceScopeGlobal() *schema.Resource {
+	return &schema.Resource{
+		Description: "The global scope resource manages the settings of the global scope, which always exists and " +
+			"can't be created or deleted. Creating the resource adopts the global scope, leaving the settings that " +
+			"aren't configured as they are, and destroying it leaves the global scope alone. There should be a " +
+			"single such resource, and the global scope should not also be managed by a `boundary_scope` resource " +
+			"with `global_scope` set.",
+
+		CreateContext: resourceScopeGlobalCreate,
+		ReadContext:   resourceScopeGlobalRead,
+		UpdateContext: resourceScopeGlobalUpdate,
+		DeleteContext: resourceScopeGlobalDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope, always `global`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The name of the global scope.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			DescriptionKey: {
+				Description: "The description of the global scope.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			scopeGlobalPrimaryAuthMethodId: {
+				Description: "The ID of the primary auth method of the global scope, the one users are created from " +
+					"automatically the first time they log in. Should not be used along with a " +
+					"`boundary_scope_primary_auth_method` resource for the global scope.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceScopeGlobalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The global scope always exists, the configured settings are applied as
+	// an update
+	d.SetId(scopeGlobalId)
+	return resourceScopeGlobalUpdate(ctx, d, meta)
+}
+
+func resourceScopeGlobalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	raw, err := md.readScope(ctx, scopeGlobalId)
+	if err != nil {
+		return diag.Errorf("error calling read scope: %v", err)
+	}
+	if raw == nil {
+		return diag.Errorf("scope nil after read")
+	}
+
+	if err := d.Set(NameKey, raw["name"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(DescriptionKey, raw["description"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(scopeGlobalPrimaryAuthMethodId, raw["primary_auth_method_id"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(scopeGlobalId)
+	return nil
+}
+
+func resourceScopeGlobalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	opts := []scopes.Option{}
+
+	if d.HasChange(NameKey) {
+		opts = append(opts, scopes.DefaultName())
+		if nameVal, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, scopes.WithName(nameVal.(string)))
+		}
+	}
+
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, scopes.DefaultDescription())
+		if descVal, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, scopes.WithDescription(descVal.(string)))
+		}
+	}
+
+	if d.HasChange(scopeGlobalPrimaryAuthMethodId) {
+		opts = append(opts, scopes.DefaultPrimaryAuthMethodId())
+		if authMethodIdVal, ok := d.GetOk(scopeGlobalPrimaryAuthMethodId); ok {
+			opts = append(opts, scopes.WithPrimaryAuthMethodId(authMethodIdVal.(string)))
+		}
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, scopes.WithAutomaticVersioning(true))
+		_, err := scp.Update(ctx, scopeGlobalId, 0, opts...)
+		md.cache.invalidate(scopeGlobalId)
+		if err != nil {
+			return diag.Errorf("error updating global scope: %v", err)
+		}
+	}
+
+	return resourceScopeGlobalRead(ctx, d, meta)
+}
+
+func resourceScopeGlobalDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The global scope can't be removed, forgetting about it is all there is
+	// to do
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	globalScopeSettings = `
+resource "boundary_auth_method_password" "global" {
+	name     = "global"
+	scope_id = "global"
+}
+
+resource "boundary_scope_global" "global" {
+	name                   = "global"
+	description            = "%s"
+	primary_auth_method_id = %s
+}`
+)
+
+func TestAccScopeGlobal(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// adopt
+				Config: testConfig(url, fmt.Sprintf(globalScopeSettings, "Global Scope", "boundary_auth_method_password.global.id")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_scope_global.global", IDKey, scopeGlobalId),
+					resource.TestCheckResourceAttr("boundary_scope_global.global", DescriptionKey, "Global Scope"),
+					resource.TestCheckResourceAttrPair("boundary_scope_global.global", scopeGlobalPrimaryAuthMethodId, "boundary_auth_method_password.global", IDKey),
+					testAccCheckScopePrimaryAuthMethod(provider, "boundary_scope_global.global", "boundary_auth_method_password.global"),
+				),
+			},
+			importStep("boundary_scope_global.global"),
+			{
+				// update
+				Config: testConfig(url, fmt.Sprintf(globalScopeSettings, "The global scope", `"`+tcPAUM+`"`)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_scope_global.global", DescriptionKey, "The global scope"),
+					resource.TestCheckResourceAttr("boundary_scope_global.global", scopeGlobalPrimaryAuthMethodId, tcPAUM),
+				),
+			},
+			importStep("boundary_scope_global.global"),
+		},
+	})
+}
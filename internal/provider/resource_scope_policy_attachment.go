@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	scopePolicyAttachmentPolicyIdKey = "policy_id"
+)
+
+func resourceScopePolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scope policy attachment resource attaches a storage policy to a scope, so the " +
+			"session recordings of the scope are kept and deleted according to the policy. A scope can " +
+			"only have a single storage policy attached.",
+
+		CreateContext: resourceScopePolicyAttachmentCreate,
+		ReadContext:   resourceScopePolicyAttachmentRead,
+		DeleteContext: resourceScopePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts:      resourceTimeouts(),
+		CustomizeDiff: requireControllerVersion("0.15.0", "boundary_scope_policy_attachment", nil),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to attach the policy to, either global or an org.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			scopePolicyAttachmentPolicyIdKey: {
+				Description: "The ID of the storage policy to attach.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceScopePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	_, err := scp.AttachStoragePolicy(ctx, scopeId, 0, d.Get(scopePolicyAttachmentPolicyIdKey).(string), scopes.WithAutomaticVersioning(true))
+	md.cache.invalidate(scopeId)
+	if err != nil {
+		return diag.Errorf("error attaching storage policy: %v", err)
+	}
+
+	d.SetId(scopeId)
+	return nil
+}
+
+func resourceScopePolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	raw, err := md.readScope(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read scope: %v", err)
+	}
+	if raw == nil {
+		return diag.Errorf("scope nil after read")
+	}
+
+	policyId, _ := raw["storage_policy_id"].(string)
+	if policyId == "" {
+		// The policy was detached outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(ScopeIdKey, raw["id"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(scopePolicyAttachmentPolicyIdKey, policyId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScopePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	_, err := scp.DetachStoragePolicy(ctx, d.Id(), 0, scopes.WithAutomaticVersioning(true))
+	md.cache.invalidate(d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error detaching storage policy: %v", err)
+	}
+
+	return nil
+}
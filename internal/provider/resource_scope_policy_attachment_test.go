@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const orgStoragePolicyAttachment = `
+resource "boundary_scope_policy_attachment" "foo" {
+	scope_id  = boundary_scope.org1.id
+	policy_id = boundary_policy_storage.foo.id
+}`
+
+func TestAccScopePolicyAttachment(t *testing.T) {
+	if os.Getenv("BOUNDARY_TF_PROVIDER_TEST_SESSION_RECORDING") == "" {
+		t.Skip("Not running scope policy attachment test without session recording support")
+	}
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// attach
+				Config: testConfig(url, fooOrg, orgStoragePolicy, orgStoragePolicyAttachment),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeStoragePolicy(provider, "boundary_scope.org1", "boundary_policy_storage.foo"),
+				),
+			},
+			importStep("boundary_scope_policy_attachment.foo"),
+			{
+				// detach
+				Config: testConfig(url, fooOrg, orgStoragePolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeStoragePolicy(provider, "boundary_scope.org1", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScopeStoragePolicy(testProvider *schema.Provider, scopeName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[scopeName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", scopeName)
+		}
+		var wantPolicyId string
+		if policyName != "" {
+			prs, ok := s.RootModule().Resources[policyName]
+			if !ok {
+				return fmt.Errorf("Not found: %s", policyName)
+			}
+			wantPolicyId = prs.Primary.ID
+		}
+
+		md := testProvider.Meta().(*metaData)
+		scp := scopes.NewClient(md.client)
+
+		srr, err := scp.Read(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading scope %q: %v", rs.Primary.ID, err)
+		}
+		if got := srr.GetItem().StoragePolicyId; got != wantPolicyId {
+			return fmt.Errorf("Expected storage policy %q, got %q", wantPolicyId, got)
+		}
+
+		return nil
+	}
+}
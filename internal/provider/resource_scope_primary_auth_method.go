@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	scopePrimaryAuthMethodIdKey = "auth_method_id"
+)
+
+func resourceScopePrimaryAuthMethod() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scope primary auth method resource sets the primary auth method of a scope, the one " +
+			"users are created from automatically the first time they log in. The `is_primary_for_scope` " +
+			"attribute of the auth method resources should not be used along with this resource. Destroying " +
+			"the resource unsets the primary auth method of the scope.",
+
+		CreateContext: resourceScopePrimaryAuthMethodSet,
+		ReadContext:   resourceScopePrimaryAuthMethodRead,
+		UpdateContext: resourceScopePrimaryAuthMethodSet,
+		DeleteContext: resourceScopePrimaryAuthMethodDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The ID of the scope to set the primary auth method of, either global or an org.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			scopePrimaryAuthMethodIdKey: {
+				Description: "The ID of the auth method to make primary. It must belong to the scope.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceScopePrimaryAuthMethodSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	_, err := scp.Update(ctx, scopeId, 0, scopes.WithPrimaryAuthMethodId(d.Get(scopePrimaryAuthMethodIdKey).(string)), scopes.WithAutomaticVersioning(true))
+	md.cache.invalidate(scopeId)
+	if err != nil {
+		return diag.Errorf("error setting primary auth method of scope: %v", err)
+	}
+
+	d.SetId(scopeId)
+	return nil
+}
+
+func resourceScopePrimaryAuthMethodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	raw, err := md.readScope(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read scope: %v", err)
+	}
+	if raw == nil {
+		return diag.Errorf("scope nil after read")
+	}
+
+	authMethodId, _ := raw["primary_auth_method_id"].(string)
+	if authMethodId == "" {
+		// The primary auth method was unset outside of Terraform, or deleted
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(ScopeIdKey, raw["id"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(scopePrimaryAuthMethodIdKey, authMethodId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScopePrimaryAuthMethodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	raw, err := md.readScope(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error calling read scope: %v", err)
+	}
+	// Leave alone a primary auth method set by someone else since
+	if authMethodId, _ := raw["primary_auth_method_id"].(string); authMethodId != d.Get(scopePrimaryAuthMethodIdKey).(string) {
+		return nil
+	}
+
+	_, err = scp.Update(ctx, d.Id(), 0, scopes.DefaultPrimaryAuthMethodId(), scopes.WithAutomaticVersioning(true))
+	md.cache.invalidate(d.Id())
+	if err != nil {
+		return diag.Errorf("error unsetting primary auth method of scope: %v", err)
+	}
+
+	return nil
+}
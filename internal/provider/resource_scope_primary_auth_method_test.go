@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	orgPrimaryAuthMethods = `
+resource "boundary_auth_method_password" "foo" {
+	name       = "foo"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}
+
+resource "boundary_auth_method_password" "bar" {
+	name       = "bar"
+	scope_id   = boundary_scope.org1.id
+	depends_on = [boundary_role.org1_admin]
+}`
+
+	orgPrimaryAuthMethod = `
+resource "boundary_scope_primary_auth_method" "foo" {
+	scope_id       = boundary_scope.org1.id
+	auth_method_id = boundary_auth_method_password.%s.id
+}`
+)
+
+func TestAccScopePrimaryAuthMethod(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// set
+				Config: testConfig(url, fooOrg, orgPrimaryAuthMethods, fmt.Sprintf(orgPrimaryAuthMethod, "foo")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopePrimaryAuthMethod(provider, "boundary_scope.org1", "boundary_auth_method_password.foo"),
+				),
+			},
+			importStep("boundary_scope_primary_auth_method.foo"),
+			{
+				// change
+				Config: testConfig(url, fooOrg, orgPrimaryAuthMethods, fmt.Sprintf(orgPrimaryAuthMethod, "bar")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopePrimaryAuthMethod(provider, "boundary_scope.org1", "boundary_auth_method_password.bar"),
+				),
+			},
+			importStep("boundary_scope_primary_auth_method.foo"),
+			{
+				// unset
+				Config: testConfig(url, fooOrg, orgPrimaryAuthMethods),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopePrimaryAuthMethod(provider, "boundary_scope.org1", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScopePrimaryAuthMethod(testProvider *schema.Provider, scopeName, authMethodName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[scopeName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", scopeName)
+		}
+		var wantAuthMethodId string
+		if authMethodName != "" {
+			ars, ok := s.RootModule().Resources[authMethodName]
+			if !ok {
+				return fmt.Errorf("Not found: %s", authMethodName)
+			}
+			wantAuthMethodId = ars.Primary.ID
+		}
+
+		md := testProvider.Meta().(*metaData)
+		scp := scopes.NewClient(md.client)
+
+		srr, err := scp.Read(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading scope %q: %v", rs.Primary.ID, err)
+		}
+		if got := srr.GetItem().PrimaryAuthMethodId; got != wantAuthMethodId {
+			return fmt.Errorf("Expected primary auth method %q, got %q", wantAuthMethodId, got)
+		}
+
+		return nil
+	}
+}
@@ -131,6 +131,45 @@ func TestAccScopeCreation(t *testing.T) {
 	})
 }
 
+func TestAccScopeAutoCreatedRoles(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	autoCreatedRolesOrg := fmt.Sprintf(`
+resource "boundary_scope" "org2" {
+	name                     = "org2"
+	scope_id                 = boundary_scope.global.id
+	auto_create_admin_role   = true
+	auto_create_default_role = true
+}
+
+resource "boundary_role_grant" "self" {
+	role_id      = boundary_scope.org2.default_role_id
+	grant_string = "%s"
+}`, roleGrantSelf)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, autoCreatedRolesOrg),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeResourceExists(provider, "boundary_scope.org2"),
+					resource.TestCheckResourceAttrSet("boundary_scope.org2", scopeAdminRoleIdKey),
+					resource.TestCheckResourceAttrSet("boundary_scope.org2", scopeDefaultRoleIdKey),
+					resource.TestCheckResourceAttrPair("boundary_role_grant.self", roleIdKey, "boundary_scope.org2", scopeDefaultRoleIdKey),
+					resource.TestCheckResourceAttr("boundary_scope.org1", scopeAdminRoleIdKey, ""),
+				),
+			},
+			// The roles can't be told apart from roles created later on import
+			importStep("boundary_scope.org2", scopeAutoCreateAdminRole, scopeAutoCreateDefaultRole, scopeAdminRoleIdKey, scopeDefaultRoleIdKey),
+		},
+	})
+}
+
 func testAccCheckScopeResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
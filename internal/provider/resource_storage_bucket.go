@@ -0,0 +1,577 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/storagebuckets"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	storageBucketBucketNameKey   = "bucket_name"
+	storageBucketBucketPrefixKey = "bucket_prefix"
+	storageBucketWorkerFilterKey = "worker_filter"
+
+	storageBucketPluginMinio = "minio"
+)
+
+func resourceStorageBucket() *schema.Resource {
+	return &schema.Resource{
+		Description: "The storage bucket resource allows you to configure a Boundary storage bucket, which holds " +
+			"the session recordings of targets in its scope. Storage buckets are always part of the global scope " +
+			"or an org, and are backed by a plugin, e.g. the \"aws\" plugin for Amazon S3.",
+
+		CreateContext: resourceStorageBucketCreate,
+		ReadContext:   resourceStorageBucketRead,
+		UpdateContext: resourceStorageBucketUpdate,
+		DeleteContext: resourceStorageBucketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the storage bucket.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The storage bucket name. Defaults to the resource name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			DescriptionKey: {
+				Description: "The storage bucket description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope ID in which the resource is created, either global or an org." + defaultScopeIdDescription,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			PluginIdKey: {
+				Description:   "The ID of the plugin that should back the resource. This or " + PluginNameKey + " must be defined.",
+				Type:          schema.TypeString,
+				ConflictsWith: []string{PluginNameKey},
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+			},
+			PluginNameKey: {
+				Description:   "The name of the plugin that should back the resource, e.g. \"aws\". This or " + PluginIdKey + " must be defined.",
+				Type:          schema.TypeString,
+				ConflictsWith: []string{PluginIdKey},
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+			},
+			storageBucketBucketNameKey: {
+				Description: "The name of the bucket within the external object store.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			storageBucketBucketPrefixKey: {
+				Description: "The prefix used to organize the data held within the external object store.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			storageBucketWorkerFilterKey: {
+				Description: "A boolean expression filtering the workers that are allowed to access the bucket, " +
+					"e.g. `\"s3\" in \"/tags/type\"`.",
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			AttributesJsonKey: {
+				Description: `The attributes for the storage bucket, e.g. "region", "role_arn" or "disable_credential_rotation" ` +
+					`for the aws plugin. S3-compatible object stores such as MinIO are used through the minio plugin, which requires ` +
+					`"endpoint_url" to be set to the URL of the object store and always uses path-style addressing. Either values ` +
+					`encoded with the "jsonencode" function, pre-escaped JSON string, or a file:// or env:// path. Set to a string "null" or remove the block to clear all attributes in the storage bucket. ` +
+					`"disable_credential_rotation" can be set here or with the attribute of the same name, but not both.`,
+				Type:     schema.TypeString,
+				Optional: true,
+				// If set to null in config and nothing comes from API, consider
+				// it the same. Same if config changes from empty to null.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if !configuredCredentialRotation(d.GetRawConfig()).IsNull() {
+						// The controller returns the attribute set through
+						// disable_credential_rotation, it isn't part of the
+						// configured attributes
+						old = jsonWithoutKey(old, DisableCredentialRotationKey)
+						new = jsonWithoutKey(new, DisableCredentialRotationKey)
+						if old == new {
+							return true
+						}
+					}
+					sanitizedNew, err := sanitizeJson(new)
+					if err != nil {
+						return false
+					}
+					new = string(sanitizedNew)
+					switch {
+					case old == new:
+						return true
+					case old == "null" && new == "":
+						return true
+					case old == "" && new == "null":
+						return true
+					default:
+						return false
+					}
+				},
+			},
+			SecretsJsonKey: {
+				Description: `The secrets for the storage bucket, e.g. "access_key_id" and "secret_access_key" for the aws plugin ` +
+					`when no role is used. Either values encoded with the "jsonencode" function, pre-escaped JSON string, ` +
+					`or a file:// or env:// path. Set to a string "null" to clear any existing values. NOTE: Unlike "attributes_json", removing ` +
+					`this block will NOT clear secrets from the storage bucket; this allows injecting secrets for one call, then removing them for storage.`,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			DisableCredentialRotationKey: {
+				Description: "Whether the plugin must leave the credentials given in `secrets_json` as they are. When false, " +
+					"plugins supporting it, such as aws, replace them with credentials only Boundary knows, so the ones " +
+					"originally supplied stop working. Sets the attribute of the same name for the plugin. If unset, the " +
+					"value given in `attributes_json` is used.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			SecretsHmacKey: {
+				Description: "The HMAC'd secrets value returned from the server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			internalSecretsConfigHmacKey: {
+				Description: "Internal only. HMAC of (serverSecretsHmac + config secrets). Used for proper secrets handling.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			internalHmacUsedForSecretsConfigHmacKey: {
+				Description: "Internal only. The Boundary-provided HMAC used to calculate the current value of the HMAC'd config. Used for drift detection.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			internalForceUpdateKey: {
+				Description: "Internal only. Used to force update so that we can always check the value of secrets.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+
+		// We want to always force an update (which itself may not actually do
+		// anything) so that we can properly check secrets state.
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if err := d.SetNewComputed(internalForceUpdateKey); err != nil {
+					return err
+				}
+				return customizeDiffDefaultScopeId(ctx, d, meta)
+			},
+			requireControllerVersion("0.13.0", "boundary_storage_bucket", nil),
+			requireControllerVersion("0.14.0", `Storage buckets backed by the "minio" plugin`, func(d *schema.ResourceDiff) bool {
+				return d.Get(PluginNameKey).(string) == storageBucketPluginMinio
+			}),
+			customizeDiffStorageBucketEndpoint,
+			customizeDiffPluginCredentialRotation,
+			checkPermissions(permissionCheck{
+				collection:       "storage-buckets",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
+	}
+}
+
+func setFromStorageBucketResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	if err := d.Set(NameKey, raw[NameKey]); err != nil {
+		return err
+	}
+	if err := d.Set(DescriptionKey, raw[DescriptionKey]); err != nil {
+		return err
+	}
+	if err := d.Set(ScopeIdKey, raw[ScopeIdKey]); err != nil {
+		return err
+	}
+	if err := d.Set(storageBucketBucketNameKey, raw[storageBucketBucketNameKey]); err != nil {
+		return err
+	}
+	if err := d.Set(storageBucketBucketPrefixKey, raw[storageBucketBucketPrefixKey]); err != nil {
+		return err
+	}
+	if err := d.Set(storageBucketWorkerFilterKey, raw[storageBucketWorkerFilterKey]); err != nil {
+		return err
+	}
+	// Plugin stuff
+	{
+		if err := d.Set(PluginIdKey, raw[PluginIdKey]); err != nil {
+			return err
+		}
+		pluginInfo, ok := raw["plugin"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("plugin field not found in response")
+		}
+		pluginName, ok := pluginInfo["name"].(string)
+		if !ok {
+			return fmt.Errorf("plugin name field not found in response")
+		}
+		if err := d.Set(PluginNameKey, pluginName); err != nil {
+			return err
+		}
+	}
+	// Attributes stuff
+	{
+		attrRaw, ok := raw["attributes"]
+		switch ok {
+		case true:
+			encodedAttributes, err := json.Marshal(attrRaw)
+			if err != nil {
+				return err
+			}
+			if err := d.Set(AttributesJsonKey, string(encodedAttributes)); err != nil {
+				return err
+			}
+		default:
+			d.Set(AttributesJsonKey, nil)
+		}
+	}
+	// Credential rotation stuff
+	{
+		attrs, _ := raw["attributes"].(map[string]interface{})
+		if err := d.Set(DisableCredentialRotationKey, credentialRotationDisabled(attrs)); err != nil {
+			return err
+		}
+	}
+	// Secrets stuff
+	{
+		// We do not save secrets into the state file, and they're not returned in
+		// the response
+		secretsHmacRaw, ok := raw[SecretsHmacKey]
+		switch ok {
+		case true:
+			if err := d.Set(SecretsHmacKey, secretsHmacRaw); err != nil {
+				return err
+			}
+		default:
+			d.Set(SecretsHmacKey, nil)
+		}
+	}
+
+	d.SetId(raw[IDKey].(string))
+
+	if err := d.Set(internalForceUpdateKey, strconv.FormatInt(rand.Int63(), 10)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// customizeDiffStorageBucketEndpoint validates the "endpoint_url" attribute of
+// S3-compatible storage buckets at plan time, since the plugin only reports
+// problems with it once a worker tries to reach the bucket.
+func customizeDiffStorageBucketEndpoint(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(AttributesJsonKey) || !d.NewValueKnown(PluginNameKey) {
+		return nil
+	}
+	_, attrs, err := parsePluginJson(d.Get(AttributesJsonKey).(string), "attributes")
+	if err != nil {
+		return err
+	}
+
+	endpoint, ok := attrs["endpoint_url"]
+	if !ok {
+		if d.Get(PluginNameKey).(string) == storageBucketPluginMinio {
+			return fmt.Errorf(`"endpoint_url" must be set in %q for storage buckets backed by the minio plugin`, AttributesJsonKey)
+		}
+		return nil
+	}
+	endpointStr, ok := endpoint.(string)
+	if !ok {
+		return fmt.Errorf(`"endpoint_url" in %q must be a string`, AttributesJsonKey)
+	}
+	u, err := url.Parse(endpointStr)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf(`"endpoint_url" in %q must be an http or https URL, got %q`, AttributesJsonKey, endpointStr)
+	}
+	return nil
+}
+
+func resourceStorageBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	var scopeId string
+	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
+		scopeId = scopeIdVal.(string)
+	} else {
+		return diag.Errorf("no scope ID provided")
+	}
+
+	opts := []storagebuckets.Option{
+		storagebuckets.WithBucketName(d.Get(storageBucketBucketNameKey).(string)),
+		storagebuckets.WithWorkerFilter(d.Get(storageBucketWorkerFilterKey).(string)),
+	}
+
+	var foundPluginId bool
+	var foundPluginName bool
+	if pluginIdVal, ok := d.GetOk(PluginIdKey); ok {
+		opts = append(opts, storagebuckets.WithPluginId(pluginIdVal.(string)))
+		foundPluginId = true
+	}
+	if pluginNameVal, ok := d.GetOk(PluginNameKey); ok {
+		opts = append(opts, storagebuckets.WithPluginName(pluginNameVal.(string)))
+		foundPluginName = true
+	}
+	if !foundPluginId && !foundPluginName {
+		return diag.Errorf("neither plugin ID nor plugin name provided")
+	}
+
+	if nameVal, ok := d.GetOk(NameKey); ok {
+		opts = append(opts, storagebuckets.WithName(nameVal.(string)))
+	}
+
+	if descVal, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, storagebuckets.WithDescription(descVal.(string)))
+	}
+
+	if prefixVal, ok := d.GetOk(storageBucketBucketPrefixKey); ok {
+		opts = append(opts, storagebuckets.WithBucketPrefix(prefixVal.(string)))
+	}
+
+	m, err := pluginAttributes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if m != nil {
+		opts = append(opts, storagebuckets.WithAttributes(m))
+	}
+
+	var secretsJson string
+	if secretsVal, ok := d.GetOk(SecretsJsonKey); ok {
+		var m map[string]interface{}
+		var err error
+		secretsJson, m, err = parsePluginJson(secretsVal.(string), "secrets")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if m != nil {
+			opts = append(opts, storagebuckets.WithSecrets(m))
+		}
+	}
+
+	sbClient := storagebuckets.NewClient(md.client)
+
+	sbcr, err := sbClient.Create(ctx, scopeId, opts...)
+	if err != nil {
+		return diag.Errorf("error creating storage bucket: %v", err)
+	}
+	if sbcr == nil {
+		return diag.Errorf("storage bucket nil after create")
+	}
+
+	if err := setFromStorageBucketResponseMap(d, sbcr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if serverHmac := d.Get(SecretsHmacKey).(string); serverHmac != "" {
+		configHmac, err := calculateCurrentConfigHmac(serverHmac, secretsJson)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(internalSecretsConfigHmacKey, configHmac); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(internalHmacUsedForSecretsConfigHmacKey, serverHmac); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	sbClient := storagebuckets.NewClient(md.client)
+
+	sbrr, err := sbClient.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading storage bucket: %v", err)
+	}
+	if sbrr == nil {
+		return diag.Errorf("storage bucket nil after read")
+	}
+
+	if err := setFromStorageBucketResponseMap(d, sbrr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	sbClient := storagebuckets.NewClient(md.client)
+
+	// We need to refresh the current server hmac value to figure out what to do
+	// next around secrets handling
+	var clearStateSecrets, sendSecretsToBoundary bool
+	var secretsJson string
+	var currentDiagnostics diag.Diagnostics
+	{
+		sbrr, err := sbClient.Read(ctx, d.Id())
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("error reading storage bucket in update: %v", err)
+		}
+		if sbrr == nil {
+			return diag.Errorf("storage bucket nil after read in update")
+		}
+		var serverSecretsHmac string
+		if secretsHmacRaw, ok := sbrr.GetResponse().Map[SecretsHmacKey]; ok {
+			serverSecretsHmac = secretsHmacRaw.(string)
+		}
+		// Get current secrets_json value
+		secretsJson, err = parseutil.ParsePath(d.Get(SecretsJsonKey).(string))
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return diag.Errorf("error parsing path with secrets: %v", err)
+		}
+		// Now that we have the value from the server, see if anything needs to be
+		// done
+		var diagWarning *diag.Diagnostic
+		clearStateSecrets, sendSecretsToBoundary, diagWarning, err = calculateConfigHmacPlan(serverSecretsHmac, secretsJson, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if diagWarning != nil {
+			currentDiagnostics = append(currentDiagnostics, *diagWarning)
+		}
+	}
+
+	opts := []storagebuckets.Option{}
+
+	if d.HasChange(NameKey) {
+		opts = append(opts, storagebuckets.DefaultName())
+		if nameVal, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, storagebuckets.WithName(nameVal.(string)))
+		}
+	}
+
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, storagebuckets.DefaultDescription())
+		if descVal, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, storagebuckets.WithDescription(descVal.(string)))
+		}
+	}
+
+	if d.HasChange(storageBucketWorkerFilterKey) {
+		opts = append(opts, storagebuckets.WithWorkerFilter(d.Get(storageBucketWorkerFilterKey).(string)))
+	}
+
+	if d.HasChanges(AttributesJsonKey, DisableCredentialRotationKey) {
+		m, err := pluginAttributes(d)
+		if err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+		if m == nil {
+			opts = append(opts, storagebuckets.DefaultAttributes())
+		} else {
+			opts = append(opts, storagebuckets.WithAttributes(m))
+		}
+	}
+
+	if sendSecretsToBoundary {
+		_, m, err := parsePluginJson(secretsJson, "secrets")
+		if err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+		if m == nil {
+			opts = append(opts, storagebuckets.DefaultSecrets())
+		} else {
+			opts = append(opts, storagebuckets.WithSecrets(m))
+		}
+	}
+
+	if len(opts) > 0 {
+		opts = append(opts, storagebuckets.WithAutomaticVersioning(true))
+		sbur, err := sbClient.Update(ctx, d.Id(), 0, opts...)
+		if err != nil {
+			return append(currentDiagnostics, diag.Errorf("error updating storage bucket: %v", err)...)
+		}
+		if sbur == nil {
+			return append(currentDiagnostics, diag.Errorf("storage bucket nil after update")...)
+		}
+
+		if err := setFromStorageBucketResponseMap(d, sbur.GetResponse().Map); err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+	}
+
+	// Save any updated secrets information if needed
+	switch {
+	case clearStateSecrets:
+		if err := d.Set(internalSecretsConfigHmacKey, nil); err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+		if err := d.Set(internalHmacUsedForSecretsConfigHmacKey, nil); err != nil {
+			return append(currentDiagnostics, diag.FromErr(err)...)
+		}
+
+	case sendSecretsToBoundary:
+		if serverHmac := d.Get(SecretsHmacKey).(string); serverHmac != "" {
+			configHmac, err := calculateCurrentConfigHmac(serverHmac, secretsJson)
+			if err != nil {
+				return append(currentDiagnostics, diag.FromErr(err)...)
+			}
+			if err := d.Set(internalSecretsConfigHmacKey, configHmac); err != nil {
+				return append(currentDiagnostics, diag.FromErr(err)...)
+			}
+			if err := d.Set(internalHmacUsedForSecretsConfigHmacKey, serverHmac); err != nil {
+				return append(currentDiagnostics, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	return currentDiagnostics
+}
+
+func resourceStorageBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	sbClient := storagebuckets.NewClient(md.client)
+
+	_, err := sbClient.Delete(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error deleting storage bucket: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/storagebuckets"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	storageBucketDesc       = "the foo bucket"
+	storageBucketDescUpdate = "the updated foo bucket"
+)
+
+// storageBucketConfig returns the configuration of an aws storage bucket,
+// disabling credential rotation with the attribute or in attributes_json.
+func storageBucketConfig(bucketName, region, desc string, rotationAsAttribute bool) string {
+	rotation := "attributes_json = jsonencode({\n\t\tregion                      = %q\n\t\tdisable_credential_rotation = true\n\t})"
+	if rotationAsAttribute {
+		rotation = "disable_credential_rotation = true\n\tattributes_json = jsonencode({\n\t\tregion = %q\n\t})"
+	}
+	return fmt.Sprintf(`
+resource "boundary_storage_bucket" "foo" {
+	name          = "foo"
+	description   = "%s"
+	scope_id      = boundary_scope.org1.id
+	plugin_name   = "aws"
+	bucket_name   = "%s"
+	bucket_prefix = "recordings"
+	worker_filter = "\"s3\" in \"/tags/type\""
+	%s
+	secrets_json = jsonencode({
+		access_key_id     = "%s"
+		secret_access_key = "%s"
+	})
+	depends_on = [boundary_role.org1_admin]
+}`, desc, bucketName, fmt.Sprintf(rotation, region), os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY"))
+}
+
+// Storage buckets are only available with session recording, which the test
+// controller doesn't support on its own, so this test needs a worker able to
+// reach an S3 bucket.
+func TestAccStorageBucketAws(t *testing.T) {
+	bucketName := os.Getenv("BOUNDARY_TF_PROVIDER_TEST_STORAGE_BUCKET_NAME")
+	region := os.Getenv("BOUNDARY_TF_PROVIDER_TEST_STORAGE_BUCKET_REGION")
+	if bucketName == "" || region == "" {
+		t.Skip("Not running storage bucket test without a storage bucket name and region")
+	}
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckStorageBucketResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, fooOrg, storageBucketConfig(bucketName, region, storageBucketDesc, false)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketResourceExists(provider, "boundary_storage_bucket.foo"),
+					resource.TestCheckResourceAttr("boundary_storage_bucket.foo", "description", storageBucketDesc),
+					resource.TestCheckResourceAttr("boundary_storage_bucket.foo", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("boundary_storage_bucket.foo", "plugin_name", "aws"),
+					resource.TestCheckResourceAttrSet("boundary_storage_bucket.foo", "secrets_hmac"),
+					resource.TestCheckResourceAttr("boundary_storage_bucket.foo", DisableCredentialRotationKey, "true"),
+				),
+			},
+			importStep("boundary_storage_bucket.foo", "secrets_json", "internal_force_update", "internal_hmac_used_for_secrets_config_hmac", "internal_secrets_config_hmac"),
+			{
+				// update
+				Config: testConfig(url, fooOrg, storageBucketConfig(bucketName, region, storageBucketDescUpdate, true)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketResourceExists(provider, "boundary_storage_bucket.foo"),
+					resource.TestCheckResourceAttr("boundary_storage_bucket.foo", "description", storageBucketDescUpdate),
+					resource.TestCheckResourceAttr("boundary_storage_bucket.foo", DisableCredentialRotationKey, "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageBucketResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		sbClient := storagebuckets.NewClient(md.client)
+
+		if _, err := sbClient.Read(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading storage bucket %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStorageBucketResourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		md := testProvider.Meta().(*metaData)
+
+		for _, rs := range s.RootModule().Resources {
+			switch rs.Type {
+			case "boundary_storage_bucket":
+				id := rs.Primary.ID
+				sbClient := storagebuckets.NewClient(md.client)
+
+				_, err := sbClient.Read(context.Background(), id)
+				if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("didn't get a 404 when reading destroyed storage bucket %q: %v", id, err)
+				}
+
+			default:
+				continue
+			}
+		}
+		return nil
+	}
+}
+
+func TestCustomizeDiffStorageBucketEndpoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		pluginName string
+		attributes string
+		wantErr    string
+	}{
+		{name: "aws", pluginName: "aws", attributes: `{"region":"us-east-1"}`},
+		{name: "minio", pluginName: "minio", attributes: `{"endpoint_url":"https://minio.example.com:9000"}`},
+		{name: "minio-without-endpoint", pluginName: "minio", attributes: `{"region":"us-east-1"}`, wantErr: `"endpoint_url" must be set`},
+		{name: "invalid-endpoint", pluginName: "minio", attributes: `{"endpoint_url":"minio.example.com"}`, wantErr: "must be an http or https URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				ScopeIdKey:                   "o_1234567890",
+				PluginNameKey:                tt.pluginName,
+				storageBucketBucketNameKey:   "bucket",
+				storageBucketWorkerFilterKey: `"s3" in "/tags/type"`,
+				AttributesJsonKey:            tt.attributes,
+			})
+			_, err := resourceStorageBucket().Diff(context.Background(), nil, config, &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -6,12 +6,16 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -19,12 +23,20 @@ const (
 	targetBrokeredCredentialSourceIdsKey    = "brokered_credential_source_ids"
 	targetInjectedAppCredentialSourceIdsKey = "injected_application_credential_source_ids"
 	targetDefaultPortKey                    = "default_port"
+	targetDefaultClientPortKey              = "default_client_port"
 	targetSessionMaxSecondsKey              = "session_max_seconds"
 	targetSessionConnectionLimitKey         = "session_connection_limit"
 	targetWorkerFilterKey                   = "worker_filter"
+	targetEgressWorkerFilterKey             = "egress_worker_filter"
+	targetIngressWorkerFilterKey            = "ingress_worker_filter"
+	targetAddressKey                        = "address"
+	targetWithAliasesKey                    = "with_aliases"
+	targetEnableSessionRecordingKey         = "enable_session_recording"
+	targetStorageBucketIdKey                = "storage_bucket_id"
 
 	targetTypeTcp = "tcp"
 	targetTypeSsh = "ssh"
+	targetTypeRdp = "rdp"
 )
 
 func resourceTarget() *schema.Resource {
@@ -38,6 +50,37 @@ func resourceTarget() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultScopeId,
+			requireControllerVersion("0.10.0", `Targets of type "ssh"`, func(d *schema.ResourceDiff) bool {
+				return d.Get(TypeKey).(string) == targetTypeSsh
+			}),
+			requireControllerVersion("0.20.0", `Targets of type "rdp"`, func(d *schema.ResourceDiff) bool {
+				return d.Get(TypeKey).(string) == targetTypeRdp
+			}),
+			requireControllerVersion("0.11.0", `The "default_client_port" of targets`, func(d *schema.ResourceDiff) bool {
+				return d.Get(targetDefaultClientPortKey).(int) != 0
+			}),
+			requireControllerVersion("0.12.0", `The "address" of targets`, func(d *schema.ResourceDiff) bool {
+				return d.Get(targetAddressKey).(string) != ""
+			}),
+			requireControllerVersion("0.12.0", `The "egress_worker_filter" and "ingress_worker_filter" of targets`, func(d *schema.ResourceDiff) bool {
+				return d.Get(targetEgressWorkerFilterKey).(string) != "" || d.Get(targetIngressWorkerFilterKey).(string) != ""
+			}),
+			requireControllerVersion("0.16.0", `The "with_aliases" block of targets`, func(d *schema.ResourceDiff) bool {
+				return len(d.Get(targetWithAliasesKey).([]interface{})) > 0
+			}),
+			requireControllerVersion("0.13.0", "Session recording", func(d *schema.ResourceDiff) bool {
+				return d.Get(targetEnableSessionRecordingKey).(bool)
+			}),
+			customizeDiffTargetSessionRecording,
+			checkPermissions(permissionCheck{
+				collection:       "targets",
+				parentKey:        ScopeIdKey,
+				parentCollection: "scopes",
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -56,15 +99,22 @@ func resourceTarget() *schema.Resource {
 				Optional:    true,
 			},
 			TypeKey: {
-				Description: "The target resource type.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description: "The target resource type, either `tcp`, `ssh` or `rdp`. SSH targets require Boundary 0.10.0 or later, " +
+					"RDP targets Boundary 0.20.0 or later.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					targetTypeTcp,
+					targetTypeSsh,
+					targetTypeRdp,
+				}, false),
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Description: "The scope ID in which the resource is created." + defaultScopeIdDescription,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			targetDefaultPortKey: {
@@ -72,11 +122,24 @@ func resourceTarget() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 			},
+			targetDefaultClientPortKey: {
+				Description:  "The default port the local listener of clients is bound to when connecting to this target. Defaults to a random port.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
 			targetHostSourceIdsKey: {
-				Description: "A list of host source ID's.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description:   "A list of host source ID's. Cannot be used alongside address.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{targetAddressKey},
+			},
+			targetAddressKey: {
+				Description:   "Optionally, a valid network address to connect to for this target. Cannot be used alongside host_source_ids.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{targetHostSourceIdsKey},
 			},
 			targetBrokeredCredentialSourceIdsKey: {
 				Description: "A list of brokered credential source ID's.",
@@ -85,10 +148,11 @@ func resourceTarget() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			targetInjectedAppCredentialSourceIdsKey: {
-				Description: "A list of injected application credential source ID's.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of injected application credential source ID's. The credentials are injected by " +
+					"the worker into the session, so users never see them. Only supported on `ssh` targets.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			targetSessionMaxSecondsKey: {
 				Type:     schema.TypeInt,
@@ -101,10 +165,66 @@ func resourceTarget() *schema.Resource {
 				Computed: true,
 			},
 			targetWorkerFilterKey: {
-				Description: "Boolean expression to filter the workers for this target",
+				Description:   "Boolean expression to filter the workers for this target. Can't be combined with `egress_worker_filter` or `ingress_worker_filter`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use egress_worker_filter instead, worker_filter is deprecated since Boundary 0.12.0.",
+				ConflictsWith: []string{targetEgressWorkerFilterKey, targetIngressWorkerFilterKey},
+			},
+			targetEgressWorkerFilterKey: {
+				Description:   "Boolean expression to filter the workers used to connect to the hosts of this target, the last hop of a multi-hop session.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{targetWorkerFilterKey},
+			},
+			targetIngressWorkerFilterKey: {
+				Description: "Boolean expression to filter the workers clients connect to for sessions of this target, the first hop of " +
+					"a multi-hop session. Only supported by HCP Boundary and Boundary Enterprise.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{targetWorkerFilterKey},
+			},
+			targetEnableSessionRecordingKey: {
+				Description: "Whether sessions of the target are recorded. Only supported on `ssh` targets, requires `storage_bucket_id`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			targetStorageBucketIdKey: {
+				Description: "The ID of the storage bucket the session recordings of the target are stored in.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			targetWithAliasesKey: {
+				Description: "Aliases pointing to the target, created and destroyed along with it. Aliases " +
+					"managed here should not also be managed with the `boundary_alias_target` resource.",
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						aliasValueKey: {
+							Description: "The value of the alias, e.g. `alias.example.com`. It must be unique across all aliases.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						ScopeIdKey: {
+							Description: "The scope ID in which the alias is created. Defaults to `global`, the only scope aliases can be created in.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "global",
+						},
+						aliasAuthorizeSessionHostIdKey: {
+							Description: "The ID of the host to connect to when a session is authorized through the alias.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -140,9 +260,26 @@ func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}
 	if err := d.Set(targetWorkerFilterKey, raw["worker_filter"]); err != nil {
 		return err
 	}
+	if err := d.Set(targetEgressWorkerFilterKey, raw["egress_worker_filter"]); err != nil {
+		return err
+	}
+	if err := d.Set(targetIngressWorkerFilterKey, raw["ingress_worker_filter"]); err != nil {
+		return err
+	}
+	if err := d.Set(targetAddressKey, raw["address"]); err != nil {
+		return err
+	}
+	// The controller leaves out false values
+	enableSessionRecording, _ := raw["enable_session_recording"].(bool)
+	if err := d.Set(targetEnableSessionRecordingKey, enableSessionRecording); err != nil {
+		return err
+	}
+	if err := d.Set(targetStorageBucketIdKey, raw["storage_bucket_id"]); err != nil {
+		return err
+	}
 
 	switch raw["type"].(string) {
-	case targetTypeTcp, targetTypeSsh:
+	case targetTypeTcp, targetTypeSsh, targetTypeRdp:
 		if attrsVal, ok := raw["attributes"]; ok {
 			attrs := attrsVal.(map[string]interface{})
 			if defPort, ok := attrs["default_port"].(json.Number); ok {
@@ -151,6 +288,14 @@ func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}
 					return err
 				}
 			}
+			// The controller leaves out the client port when it isn't set
+			var defClientPortInt int64
+			if defClientPort, ok := attrs["default_client_port"].(json.Number); ok {
+				defClientPortInt, _ = defClientPort.Int64()
+			}
+			if err := d.Set(targetDefaultClientPortKey, int(defClientPortInt)); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -175,7 +320,7 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("no type provided")
 	}
 	switch typeStr {
-	case targetTypeTcp, targetTypeSsh:
+	case targetTypeTcp, targetTypeSsh, targetTypeRdp:
 	default:
 		return diag.Errorf("invalid type provided")
 	}
@@ -204,6 +349,19 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 			opts = append(opts, targets.WithTcpTargetDefaultPort(uint32(defaultPortInt)))
 		case targetTypeSsh:
 			opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
+		case targetTypeRdp:
+			opts = append(opts, targets.WithRdpTargetDefaultPort(uint32(defaultPortInt)))
+		}
+	}
+
+	if defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey); ok {
+		switch typeStr {
+		case targetTypeTcp:
+			opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortVal.(int))))
+		case targetTypeSsh:
+			opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortVal.(int))))
+		case targetTypeRdp:
+			opts = append(opts, targets.WithRdpTargetDefaultClientPort(uint32(defaultClientPortVal.(int))))
 		}
 	}
 
@@ -258,6 +416,30 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		opts = append(opts, targets.WithWorkerFilter(workerFilterStr))
 	}
 
+	if egressWorkerFilterVal, ok := d.GetOk(targetEgressWorkerFilterKey); ok {
+		opts = append(opts, targets.WithEgressWorkerFilter(egressWorkerFilterVal.(string)))
+	}
+
+	if ingressWorkerFilterVal, ok := d.GetOk(targetIngressWorkerFilterKey); ok {
+		opts = append(opts, targets.WithIngressWorkerFilter(ingressWorkerFilterVal.(string)))
+	}
+
+	addressVal, ok := d.GetOk(targetAddressKey)
+	if ok {
+		addressStr := addressVal.(string)
+		opts = append(opts, targets.WithAddress(addressStr))
+	}
+
+	if d.Get(targetEnableSessionRecordingKey).(bool) {
+		opts = append(opts, targets.WithEnableSessionRecording(true))
+	}
+
+	storageBucketIdVal, ok := d.GetOk(targetStorageBucketIdKey)
+	if ok {
+		storageBucketIdStr := storageBucketIdVal.(string)
+		opts = append(opts, targets.WithStorageBucketId(storageBucketIdStr))
+	}
+
 	tc := targets.NewClient(md.client)
 	tcr, err := tc.Create(ctx, typeStr, scopeId, opts...)
 	if err != nil {
@@ -304,6 +486,16 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		apiResponse = tur.GetResponse().Map
 	}
 
+	if aliasesVal, ok := d.GetOk(targetWithAliasesKey); ok {
+		created, err := createTargetAliases(ctx, md, tcr.Item.Id, aliasesVal.([]interface{}))
+		if err := d.Set(targetWithAliasesKey, created); err != nil {
+			return diag.FromErr(err)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -327,6 +519,14 @@ func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
+	targetAliases, err := readTargetAliases(ctx, md, d.Get(targetWithAliasesKey).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(targetWithAliasesKey, targetAliases); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -341,7 +541,7 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		typeStr = typeVal.(string)
 	}
 	switch typeStr {
-	case targetTypeTcp, targetTypeSsh:
+	case targetTypeTcp, targetTypeSsh, targetTypeRdp:
 	default:
 		return diag.Errorf("invalid type provided")
 	}
@@ -394,6 +594,44 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 				defaultPort = &defaultPortInt
 				opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
 			}
+
+		case targetTypeRdp:
+			opts = append(opts, targets.DefaultRdpTargetDefaultPort())
+			defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
+			if ok {
+				defaultPortInt := defaultPortVal.(int)
+				if defaultPortInt < 0 || defaultPortInt > 65535 {
+					return diag.Errorf(`"default_port" must be a valid tcp port`)
+				}
+				defaultPort = &defaultPortInt
+				opts = append(opts, targets.WithRdpTargetDefaultPort(uint32(defaultPortInt)))
+			}
+		}
+	}
+
+	var defaultClientPort *int
+	if d.HasChange(targetDefaultClientPortKey) {
+		defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
+		switch typeStr {
+		case targetTypeTcp:
+			opts = append(opts, targets.DefaultTcpTargetDefaultClientPort())
+			if ok {
+				opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortVal.(int))))
+			}
+		case targetTypeSsh:
+			opts = append(opts, targets.DefaultSshTargetDefaultClientPort())
+			if ok {
+				opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortVal.(int))))
+			}
+		case targetTypeRdp:
+			opts = append(opts, targets.DefaultRdpTargetDefaultClientPort())
+			if ok {
+				opts = append(opts, targets.WithRdpTargetDefaultClientPort(uint32(defaultClientPortVal.(int))))
+			}
+		}
+		if ok {
+			defaultClientPortInt := defaultClientPortVal.(int)
+			defaultClientPort = &defaultClientPortInt
 		}
 	}
 
@@ -436,6 +674,75 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	var egressWorkerFilter *string
+	if d.HasChange(targetEgressWorkerFilterKey) {
+		opts = append(opts, targets.DefaultEgressWorkerFilter())
+		if egressWorkerFilterVal, ok := d.GetOk(targetEgressWorkerFilterKey); ok {
+			egressWorkerFilterStr := egressWorkerFilterVal.(string)
+			egressWorkerFilter = &egressWorkerFilterStr
+			opts = append(opts, targets.WithEgressWorkerFilter(egressWorkerFilterStr))
+		}
+	}
+
+	var ingressWorkerFilter *string
+	if d.HasChange(targetIngressWorkerFilterKey) {
+		opts = append(opts, targets.DefaultIngressWorkerFilter())
+		if ingressWorkerFilterVal, ok := d.GetOk(targetIngressWorkerFilterKey); ok {
+			ingressWorkerFilterStr := ingressWorkerFilterVal.(string)
+			ingressWorkerFilter = &ingressWorkerFilterStr
+			opts = append(opts, targets.WithIngressWorkerFilter(ingressWorkerFilterStr))
+		}
+	}
+
+	var address *string
+	if d.HasChange(targetAddressKey) {
+		opts = append(opts, targets.DefaultAddress())
+		addressVal, ok := d.GetOk(targetAddressKey)
+		if ok {
+			addressStr := addressVal.(string)
+			address = &addressStr
+			opts = append(opts, targets.WithAddress(addressStr))
+		}
+	}
+
+	if d.HasChange(targetEnableSessionRecordingKey) {
+		opts = append(opts, targets.WithEnableSessionRecording(d.Get(targetEnableSessionRecordingKey).(bool)))
+	}
+
+	var storageBucketId *string
+	if d.HasChange(targetStorageBucketIdKey) {
+		opts = append(opts, targets.DefaultStorageBucketId())
+		storageBucketIdVal, ok := d.GetOk(targetStorageBucketIdKey)
+		if ok {
+			storageBucketIdStr := storageBucketIdVal.(string)
+			storageBucketId = &storageBucketIdStr
+			opts = append(opts, targets.WithStorageBucketId(storageBucketIdStr))
+		}
+	}
+
+	// A target can't have an address and host sources at the same time, so
+	// when moving from host sources to an address the host sources have to
+	// go first. Moving the other way the address is cleared by the update
+	// below, before the host sources are set.
+	var hostSourceIds []string
+	if hostSourceIdsVal, ok := d.GetOk(targetHostSourceIdsKey); ok {
+		hostSources := hostSourceIdsVal.(*schema.Set).List()
+		for _, hostSource := range hostSources {
+			hostSourceIds = append(hostSourceIds, hostSource.(string))
+		}
+	}
+	hostSourcesChanged := d.HasChange(targetHostSourceIdsKey)
+	if hostSourcesChanged && len(hostSourceIds) == 0 {
+		_, err := tc.SetHostSources(ctx, d.Id(), 0, nil, targets.WithAutomaticVersioning(true))
+		if err != nil {
+			return diag.Errorf("error updating host sources in target: %v", err)
+		}
+		if err := d.Set(targetHostSourceIdsKey, hostSourceIds); err != nil {
+			return diag.FromErr(err)
+		}
+		hostSourcesChanged = false
+	}
+
 	if len(opts) > 0 {
 		opts = append(opts, targets.WithAutomaticVersioning(true))
 		_, err := tc.Update(ctx, d.Id(), 0, opts...)
@@ -459,6 +766,11 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return diag.FromErr(err)
 		}
 	}
+	if d.HasChange(targetDefaultClientPortKey) {
+		if err := d.Set(targetDefaultClientPortKey, defaultClientPort); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if d.HasChange(targetSessionMaxSecondsKey) {
 		if err := d.Set(targetSessionMaxSecondsKey, sessionMaxSeconds); err != nil {
 			return diag.FromErr(err)
@@ -474,17 +786,30 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return diag.FromErr(err)
 		}
 	}
+	if d.HasChange(targetEgressWorkerFilterKey) {
+		if err := d.Set(targetEgressWorkerFilterKey, egressWorkerFilter); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(targetIngressWorkerFilterKey) {
+		if err := d.Set(targetIngressWorkerFilterKey, ingressWorkerFilter); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(targetAddressKey) {
+		if err := d.Set(targetAddressKey, address); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(targetStorageBucketIdKey) {
+		if err := d.Set(targetStorageBucketIdKey, storageBucketId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	// The above call may not actually happen, so we use d.Id() and automatic
 	// versioning here
-	if d.HasChange(targetHostSourceIdsKey) {
-		var hostSourceIds []string
-		if hostSourceIdsVal, ok := d.GetOk(targetHostSourceIdsKey); ok {
-			hostSources := hostSourceIdsVal.(*schema.Set).List()
-			for _, hostSource := range hostSources {
-				hostSourceIds = append(hostSourceIds, hostSource.(string))
-			}
-		}
+	if hostSourcesChanged {
 		_, err := tc.SetHostSources(ctx, d.Id(), 0, hostSourceIds, targets.WithAutomaticVersioning(true))
 		if err != nil {
 			return diag.Errorf("error updating host sources in target: %v", err)
@@ -537,6 +862,17 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange(targetWithAliasesKey) {
+		oldVal, newVal := d.GetChange(targetWithAliasesKey)
+		targetAliases, err := updateTargetAliases(ctx, md, d.Id(), oldVal.([]interface{}), newVal.([]interface{}))
+		if err := d.Set(targetWithAliasesKey, targetAliases); err != nil {
+			return diag.FromErr(err)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -544,6 +880,12 @@ func resourceTargetDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	md := meta.(*metaData)
 	tc := targets.NewClient(md.client)
 
+	// Deleting the target would only detach its aliases, so they are removed
+	// first
+	if _, err := updateTargetAliases(ctx, md, d.Id(), d.Get(targetWithAliasesKey).([]interface{}), nil); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, err := tc.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("error deleting target: %s", err.Error())
@@ -551,3 +893,159 @@ func resourceTargetDelete(ctx context.Context, d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+// customizeDiffTargetSessionRecording checks at plan time that session
+// recording is only enabled on SSH targets that have a storage bucket to store
+// the recordings in.
+func customizeDiffTargetSessionRecording(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get(targetEnableSessionRecordingKey).(bool) {
+		return nil
+	}
+	if d.NewValueKnown(TypeKey) && d.Get(TypeKey).(string) != targetTypeSsh {
+		return fmt.Errorf("%q is only supported on targets of type %q", targetEnableSessionRecordingKey, targetTypeSsh)
+	}
+	if d.NewValueKnown(targetStorageBucketIdKey) && d.Get(targetStorageBucketIdKey).(string) == "" {
+		return fmt.Errorf("%q must be set when %q is true", targetStorageBucketIdKey, targetEnableSessionRecordingKey)
+	}
+	return nil
+}
+
+// targetAliasFromResponseMap returns the "with_aliases" element describing an
+// alias read from Boundary.
+func targetAliasFromResponseMap(raw map[string]interface{}) map[string]interface{} {
+	var hostId interface{}
+	if attrs, ok := raw["attributes"].(map[string]interface{}); ok {
+		if args, ok := attrs["authorize_session_arguments"].(map[string]interface{}); ok {
+			hostId = args["host_id"]
+		}
+	}
+	return map[string]interface{}{
+		IDKey:                          raw["id"],
+		aliasValueKey:                  raw["value"],
+		ScopeIdKey:                     raw["scope_id"],
+		aliasAuthorizeSessionHostIdKey: hostId,
+	}
+}
+
+// createTargetAliases creates the aliases of the "with_aliases" block pointing
+// to targetId. The aliases created before an error are returned along with it
+// so they are kept in the state.
+func createTargetAliases(ctx context.Context, md *metaData, targetId string, want []interface{}) ([]interface{}, error) {
+	aClient := aliases.NewClient(md.client)
+
+	created := make([]interface{}, 0, len(want))
+	for _, w := range want {
+		alias := w.(map[string]interface{})
+		opts := []aliases.Option{
+			aliases.WithValue(alias[aliasValueKey].(string)),
+			aliases.WithDestinationId(targetId),
+		}
+		if hostId, _ := alias[aliasAuthorizeSessionHostIdKey].(string); hostId != "" {
+			opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(hostId))
+		}
+		acr, err := aClient.Create(ctx, aliasTypeTarget, alias[ScopeIdKey].(string), opts...)
+		if err != nil {
+			return created, fmt.Errorf("error creating alias %q: %w", alias[aliasValueKey], err)
+		}
+		created = append(created, targetAliasFromResponseMap(acr.GetResponse().Map))
+	}
+	return created, nil
+}
+
+// readTargetAliases reads the aliases of the "with_aliases" block from
+// Boundary. Aliases deleted outside of Terraform are left out so they are
+// created again.
+func readTargetAliases(ctx context.Context, md *metaData, current []interface{}) ([]interface{}, error) {
+	aClient := aliases.NewClient(md.client)
+
+	read := make([]interface{}, 0, len(current))
+	for _, c := range current {
+		alias := c.(map[string]interface{})
+		id, _ := alias[IDKey].(string)
+		if id == "" {
+			continue
+		}
+		arr, err := aClient.Read(ctx, id)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("error reading alias %q: %w", id, err)
+		}
+		read = append(read, targetAliasFromResponseMap(arr.GetResponse().Map))
+	}
+	return read, nil
+}
+
+// updateTargetAliases changes the aliases pointing to targetId from old to
+// want. Aliases are matched by value: the ones no longer wanted are deleted
+// first so their values can be reused, then the others are updated or
+// created. It returns the aliases now in Boundary.
+func updateTargetAliases(ctx context.Context, md *metaData, targetId string, old, want []interface{}) ([]interface{}, error) {
+	aClient := aliases.NewClient(md.client)
+
+	wanted := make(map[string]map[string]interface{}, len(want))
+	for _, w := range want {
+		alias := w.(map[string]interface{})
+		wanted[alias[aliasValueKey].(string)] = alias
+	}
+
+	existing := make(map[string]map[string]interface{}, len(old))
+	for i, o := range old {
+		alias := o.(map[string]interface{})
+		id, _ := alias[IDKey].(string)
+		if id == "" {
+			continue
+		}
+		value := alias[aliasValueKey].(string)
+		if w, ok := wanted[value]; ok && w[ScopeIdKey] == alias[ScopeIdKey] {
+			existing[value] = alias
+			continue
+		}
+		_, err := aClient.Delete(ctx, id)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				// Keep the aliases that are still around in the state
+				current := make([]interface{}, 0, len(old))
+				for _, e := range existing {
+					current = append(current, e)
+				}
+				return append(current, old[i:]...), fmt.Errorf("error deleting alias %q: %w", value, err)
+			}
+		}
+	}
+
+	result := make([]interface{}, 0, len(want))
+	for _, w := range want {
+		alias := w.(map[string]interface{})
+		value := alias[aliasValueKey].(string)
+		e, ok := existing[value]
+		if !ok {
+			created, err := createTargetAliases(ctx, md, targetId, []interface{}{alias})
+			result = append(result, created...)
+			if err != nil {
+				return result, err
+			}
+			continue
+		}
+
+		hostId, _ := alias[aliasAuthorizeSessionHostIdKey].(string)
+		if existingHostId, _ := e[aliasAuthorizeSessionHostIdKey].(string); hostId == existingHostId {
+			result = append(result, e)
+			continue
+		}
+		opts := []aliases.Option{
+			aliases.DefaultTargetAliasAuthorizeSessionArgumentsHostId(),
+			aliases.WithAutomaticVersioning(true),
+		}
+		if hostId != "" {
+			opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(hostId))
+		}
+		aur, err := aClient.Update(ctx, e[IDKey].(string), 0, opts...)
+		if err != nil {
+			return append(result, e), fmt.Errorf("error updating alias %q: %w", value, err)
+		}
+		result = append(result, targetAliasFromResponseMap(aur.GetResponse().Map))
+	}
+	return result, nil
+}
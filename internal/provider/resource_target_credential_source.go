@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	targetIdKey                      = "target_id"
+	targetCredentialSourceIdKey      = "credential_source_id"
+	targetCredentialSourcePurposeKey = "purpose"
+
+	credentialPurposeBrokered            = "brokered"
+	credentialPurposeInjectedApplication = "injected_application"
+)
+
+func resourceTargetCredentialSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target credential source resource adds a single credential source, a credential library " +
+			"or a credential, to an existing target, leaving the other credential sources of the target alone. " +
+			"The `boundary_target` resource managing the target should not set the credential source IDs of the " +
+			"same purpose, and should ignore changes to them with a `lifecycle` block.",
+
+		CreateContext: resourceTargetCredentialSourceCreate,
+		ReadContext:   resourceTargetCredentialSourceRead,
+		DeleteContext: resourceTargetCredentialSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			parentKey:        targetIdKey,
+			parentCollection: "targets",
+			parentAction:     "add-credential-sources",
+		}),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the target credential source, in the form `<target_id>:<credential_source_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetIdKey: {
+				Description: "The ID of the target to add the credential source to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			targetCredentialSourceIdKey: {
+				Description: "The ID of the credential library or credential to add to the target.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			targetCredentialSourcePurposeKey: {
+				Description: "How the credentials are used, either `brokered` to return them to the user connecting " +
+					"to the target, or `injected_application` to have the worker inject them into the session, which " +
+					"is only supported on `ssh` targets. Defaults to `brokered`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      credentialPurposeBrokered,
+				ValidateFunc: validation.StringInSlice([]string{credentialPurposeBrokered, credentialPurposeInjectedApplication}, false),
+			},
+		},
+	}
+}
+
+// parseTargetCredentialSourceId splits the ID of a target credential source
+// into the ID of the target and the credential source.
+func parseTargetCredentialSourceId(id string) (string, string, error) {
+	targetId, credentialSourceId, ok := strings.Cut(id, ":")
+	if !ok || targetId == "" || credentialSourceId == "" {
+		return "", "", fmt.Errorf("invalid target credential source ID %q, expected <target_id>:<credential_source_id>", id)
+	}
+	return targetId, credentialSourceId, nil
+}
+
+// targetCredentialSourceOption returns the option adding or removing the
+// credential source with the given purpose.
+func targetCredentialSourceOption(purpose, credentialSourceId string) targets.Option {
+	if purpose == credentialPurposeInjectedApplication {
+		return targets.WithInjectedApplicationCredentialSourceIds([]string{credentialSourceId})
+	}
+	return targets.WithBrokeredCredentialSourceIds([]string{credentialSourceId})
+}
+
+func resourceTargetCredentialSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+	purpose := d.Get(targetCredentialSourcePurposeKey).(string)
+	defer md.locks.lock(targetId)()
+	_, err := tc.AddCredentialSources(ctx, targetId, 0, targetCredentialSourceOption(purpose, credentialSourceId), targets.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding credential source to target: %v", err)
+	}
+
+	d.SetId(targetId + ":" + credentialSourceId)
+	return nil
+}
+
+func resourceTargetCredentialSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId, credentialSourceId, err := parseTargetCredentialSourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read target: %v", err)
+	}
+	if trr == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	var purpose string
+	for p, ids := range map[string][]string{
+		credentialPurposeBrokered:            trr.Item.BrokeredCredentialSourceIds,
+		credentialPurposeInjectedApplication: trr.Item.InjectedApplicationCredentialSourceIds,
+	} {
+		for _, id := range ids {
+			if id == credentialSourceId {
+				purpose = p
+			}
+		}
+	}
+	if purpose == "" {
+		// The credential source was removed outside of Terraform, or deleted
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(targetIdKey, targetId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(targetCredentialSourceIdKey, credentialSourceId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(targetCredentialSourcePurposeKey, purpose); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTargetCredentialSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	defer md.locks.lock(targetId)()
+
+	opt := targetCredentialSourceOption(d.Get(targetCredentialSourcePurposeKey).(string), d.Get(targetCredentialSourceIdKey).(string))
+	_, err := tc.RemoveCredentialSources(ctx, targetId, 0, opt, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing credential source from target: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	sharedCredentialSourcesTarget = `
+resource "boundary_target" "shared" {
+	name         = "shared"
+	type         = "ssh"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.1"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	lifecycle {
+		ignore_changes = [brokered_credential_source_ids, injected_application_credential_source_ids]
+	}
+}`
+
+	sharedTargetCredentialSources = `
+resource "boundary_target_credential_source" "foo" {
+	target_id            = boundary_target.shared.id
+	credential_source_id = boundary_credential_username_password.foo.id
+	purpose              = "injected_application"
+}
+
+resource "boundary_target_credential_source" "bar" {
+	target_id            = boundary_target.shared.id
+	credential_source_id = boundary_credential_username_password.bar.id
+}`
+
+	sharedTargetCredentialSourcesUpdate = `
+resource "boundary_target_credential_source" "foo" {
+	target_id            = boundary_target.shared.id
+	credential_source_id = boundary_credential_username_password.foo.id
+	purpose              = "injected_application"
+}`
+)
+
+func TestAccTargetCredentialSource(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// add a credential source of each purpose to the target
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSshCredentials, sharedCredentialSourcesTarget, sharedTargetCredentialSources),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceInjectedAppCredSources(provider, "boundary_target.shared", []string{"boundary_credential_username_password.foo"}),
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.shared", []string{"boundary_credential_username_password.bar"}),
+					resource.TestCheckResourceAttr("boundary_target_credential_source.bar", targetCredentialSourcePurposeKey, credentialPurposeBrokered),
+				),
+			},
+			importStep("boundary_target_credential_source.foo"),
+			importStep("boundary_target_credential_source.bar"),
+			{
+				// remove one of them, leaving the other alone
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSshCredentials, sharedCredentialSourcesTarget, sharedTargetCredentialSourcesUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceInjectedAppCredSources(provider, "boundary_target.shared", []string{"boundary_credential_username_password.foo"}),
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.shared", nil),
+				),
+			},
+		},
+	})
+}
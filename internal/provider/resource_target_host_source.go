@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	targetHostSetIdKey = "host_set_id"
+)
+
+func resourceTargetHostSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target host source resource adds a single host set to an existing target, leaving the " +
+			"other host sources of the target alone. The `boundary_target` resource managing the target should " +
+			"not set `host_source_ids` or `address`, and should ignore changes to `host_source_ids` with a " +
+			"`lifecycle` block.",
+
+		CreateContext: resourceTargetHostSourceCreate,
+		ReadContext:   resourceTargetHostSourceRead,
+		DeleteContext: resourceTargetHostSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			parentKey:        targetIdKey,
+			parentCollection: "targets",
+			parentAction:     "add-host-sources",
+		}),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the target host source, in the form `<target_id>:<host_set_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetIdKey: {
+				Description: "The ID of the target to add the host set to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			targetHostSetIdKey: {
+				Description: "The ID of the host set to add to the target.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// parseTargetHostSourceId splits the ID of a target host source into the ID
+// of the target and the host set.
+func parseTargetHostSourceId(id string) (string, string, error) {
+	targetId, hostSetId, ok := strings.Cut(id, ":")
+	if !ok || targetId == "" || hostSetId == "" {
+		return "", "", fmt.Errorf("invalid target host source ID %q, expected <target_id>:<host_set_id>", id)
+	}
+	return targetId, hostSetId, nil
+}
+
+func resourceTargetHostSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	hostSetId := d.Get(targetHostSetIdKey).(string)
+	defer md.locks.lock(targetId)()
+	_, err := tc.AddHostSources(ctx, targetId, 0, []string{hostSetId}, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding host source to target: %v", err)
+	}
+
+	d.SetId(targetId + ":" + hostSetId)
+	return nil
+}
+
+func resourceTargetHostSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId, hostSetId, err := parseTargetHostSourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read target: %v", err)
+	}
+	if trr == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	found := false
+	for _, id := range trr.Item.HostSourceIds {
+		if id == hostSetId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// The host source was removed outside of Terraform, or deleted
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(targetIdKey, targetId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(targetHostSetIdKey, hostSetId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTargetHostSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	defer md.locks.lock(targetId)()
+
+	_, err := tc.RemoveHostSources(ctx, targetId, 0, []string{d.Get(targetHostSetIdKey).(string)}, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing host source from target: %v", err)
+	}
+
+	return nil
+}
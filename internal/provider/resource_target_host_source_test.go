@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	sharedHostSourcesTarget = `
+resource "boundary_target" "shared" {
+	name         = "shared"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	lifecycle {
+		ignore_changes = [host_source_ids]
+	}
+}`
+
+	sharedTargetHostSources = `
+resource "boundary_target_host_source" "foo" {
+	target_id   = boundary_target.shared.id
+	host_set_id = boundary_host_set.foo.id
+}
+
+resource "boundary_target_host_source" "bar" {
+	target_id   = boundary_target.shared.id
+	host_set_id = boundary_host_set.bar.id
+}`
+
+	sharedTargetHostSourcesUpdate = `
+resource "boundary_target_host_source" "foo" {
+	target_id   = boundary_target.shared.id
+	host_set_id = boundary_host_set.foo.id
+}`
+)
+
+func TestAccTargetHostSource(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// add two host sets to the target
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, sharedHostSourcesTarget, sharedTargetHostSources),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.shared", []string{"boundary_host_set.foo", "boundary_host_set.bar"}),
+				),
+			},
+			importStep("boundary_target_host_source.foo"),
+			importStep("boundary_target_host_source.bar"),
+			{
+				// remove one of them, leaving the other alone
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, sharedHostSourcesTarget, sharedTargetHostSourcesUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.shared", []string{"boundary_host_set.foo"}),
+				),
+			},
+		},
+	})
+}
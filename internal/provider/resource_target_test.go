@@ -5,18 +5,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/testing/controller"
 	"github.com/hashicorp/boundary/testing/vault"
+	goversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -97,6 +102,7 @@ resource "boundary_target" "foo" {
 		boundary_credential_library_vault.foo.id
 	]
 	default_port = 22
+	default_client_port = 2222
 	depends_on  = [boundary_role.proj1_admin]
 	session_max_seconds = 6000
 	session_connection_limit = 6
@@ -116,6 +122,7 @@ resource "boundary_target" "foo" {
 		boundary_credential_library_vault.bar.id
 	]
 	default_port = 80
+	default_client_port = 8080
 	depends_on  = [boundary_role.proj1_admin]
 	session_max_seconds = 7000
 	session_connection_limit = 7
@@ -149,6 +156,160 @@ resource "boundary_target" "foo" {
 		boundary_credential_library_vault.bar.id
 	]
 }`, fooTargetDescription)
+
+	fooSshCredentials = `
+resource "boundary_credential_store_static" "example" {
+	name        = "static store"
+	description = "static credential store"
+	scope_id    = boundary_scope.proj1.id
+	depends_on  = [boundary_role.proj1_admin]
+}
+
+resource "boundary_credential_username_password" "foo" {
+	name                = "foo"
+	description         = "foo credential"
+	credential_store_id = boundary_credential_store_static.example.id
+	username            = "foo"
+	password            = "foo_password"
+}
+
+resource "boundary_credential_username_password" "bar" {
+	name                = "bar"
+	description         = "bar credential"
+	credential_store_id = boundary_credential_store_static.example.id
+	username            = "bar"
+	password            = "bar_password"
+}`
+
+	fooSshTarget = fmt.Sprintf(`
+resource "boundary_target" "ssh" {
+	name         = "ssh"
+	description  = "%s"
+	type         = "ssh"
+	scope_id     = boundary_scope.proj1.id
+	host_source_ids = [
+		boundary_host_set.foo.id
+	]
+	injected_application_credential_source_ids = [
+		boundary_credential_username_password.foo.id
+	]
+	default_port = 22
+	depends_on  = [boundary_role.proj1_admin]
+}`, fooTargetDescription)
+
+	fooSshTargetUpdate = fmt.Sprintf(`
+resource "boundary_target" "ssh" {
+	name         = "ssh"
+	description  = "%s"
+	type         = "ssh"
+	scope_id     = boundary_scope.proj1.id
+	host_source_ids = [
+		boundary_host_set.bar.id
+	]
+	injected_application_credential_source_ids = [
+		boundary_credential_username_password.bar.id
+	]
+	default_port = 2222
+	depends_on  = [boundary_role.proj1_admin]
+}`, fooTargetDescriptionUpdate)
+
+	fooRdpTarget = fmt.Sprintf(`
+resource "boundary_target" "rdp" {
+	name                = "rdp"
+	description         = "%s"
+	type                = "rdp"
+	scope_id            = boundary_scope.proj1.id
+	host_source_ids     = [boundary_host_set.foo.id]
+	default_port        = 3389
+	default_client_port = 13389
+	depends_on          = [boundary_role.proj1_admin]
+}`, fooTargetDescription)
+
+	fooRdpTargetUpdate = fmt.Sprintf(`
+resource "boundary_target" "rdp" {
+	name            = "rdp"
+	description     = "%s"
+	type            = "rdp"
+	scope_id        = boundary_scope.proj1.id
+	host_source_ids = [boundary_host_set.bar.id]
+	default_port    = 3390
+	depends_on      = [boundary_role.proj1_admin]
+}`, fooTargetDescriptionUpdate)
+
+	fooAddressTarget = `
+resource "boundary_target" "address" {
+	name         = "address"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "%s"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooAddressTargetHostSources = `
+resource "boundary_target" "address" {
+	name         = "address"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	host_source_ids = [
+		boundary_host_set.foo.id
+	]
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooWorkerFilterTarget = `
+resource "boundary_target" "filtered" {
+	name         = "filtered"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.1"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	%s
+}`
+
+	fooTargetWithAliases = `
+resource "boundary_target" "aliased" {
+	name         = "aliased"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.1"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	with_aliases {
+		value = "foo.example.com"
+	}
+
+	with_aliases {
+		value = "%s"
+	}
+}`
+
+	fooTargetWithoutAliases = `
+resource "boundary_target" "aliased" {
+	name         = "aliased"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.1"
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooAddressTargetConflict = `
+resource "boundary_target" "address" {
+	name         = "address"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	address      = "127.0.0.1"
+	host_source_ids = [
+		boundary_host_set.foo.id
+	]
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}`
 )
 
 func TestAccTarget(t *testing.T) {
@@ -181,6 +342,7 @@ func TestAccTarget(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_target.foo", DescriptionKey, fooTargetDescription),
 					resource.TestCheckResourceAttr("boundary_target.foo", NameKey, "test"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultPortKey, "22"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultClientPortKey, "2222"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "6000"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionConnectionLimitKey, "6"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetWorkerFilterKey, `type == "foo"`),
@@ -196,6 +358,7 @@ func TestAccTarget(t *testing.T) {
 					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
 					resource.TestCheckResourceAttr("boundary_target.foo", DescriptionKey, fooTargetDescriptionUpdate),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultPortKey, "80"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultClientPortKey, "8080"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "7000"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionConnectionLimitKey, "7"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetWorkerFilterKey, `type == "bar"`),
@@ -211,6 +374,7 @@ func TestAccTarget(t *testing.T) {
 					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
 					resource.TestCheckResourceAttr("boundary_target.foo", DescriptionKey, fooTargetDescriptionUpdate),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultPortKey, "80"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultClientPortKey, "0"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "7000"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionConnectionLimitKey, "7"),
 					resource.TestCheckResourceAttr("boundary_target.foo", targetWorkerFilterKey, `type == "bar"`),
@@ -254,6 +418,295 @@ func TestAccTarget(t *testing.T) {
 	})
 }
 
+func TestAccTargetSsh(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSshCredentials, fooBarHostSet, fooSshTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.ssh"),
+					resource.TestCheckResourceAttr("boundary_target.ssh", TypeKey, targetTypeSsh),
+					resource.TestCheckResourceAttr("boundary_target.ssh", DescriptionKey, fooTargetDescription),
+					resource.TestCheckResourceAttr("boundary_target.ssh", targetDefaultPortKey, "22"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.ssh", []string{"boundary_host_set.foo"}),
+					testAccCheckTargetResourceInjectedAppCredSources(provider, "boundary_target.ssh", []string{"boundary_credential_username_password.foo"}),
+				),
+			},
+			importStep("boundary_target.ssh"),
+			{
+				// test update
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooSshCredentials, fooBarHostSet, fooSshTargetUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.ssh"),
+					resource.TestCheckResourceAttr("boundary_target.ssh", DescriptionKey, fooTargetDescriptionUpdate),
+					resource.TestCheckResourceAttr("boundary_target.ssh", targetDefaultPortKey, "2222"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.ssh", []string{"boundary_host_set.bar"}),
+					testAccCheckTargetResourceInjectedAppCredSources(provider, "boundary_target.ssh", []string{"boundary_credential_username_password.bar"}),
+				),
+			},
+			importStep("boundary_target.ssh"),
+		},
+	})
+}
+
+func TestAccTargetRdp(t *testing.T) {
+	skipForTestControllerVersion(t, "0.20.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooRdpTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.rdp"),
+					resource.TestCheckResourceAttr("boundary_target.rdp", TypeKey, targetTypeRdp),
+					resource.TestCheckResourceAttr("boundary_target.rdp", DescriptionKey, fooTargetDescription),
+					resource.TestCheckResourceAttr("boundary_target.rdp", targetDefaultPortKey, "3389"),
+					resource.TestCheckResourceAttr("boundary_target.rdp", targetDefaultClientPortKey, "13389"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.rdp", []string{"boundary_host_set.foo"}),
+				),
+			},
+			importStep("boundary_target.rdp"),
+			{
+				// test update
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooRdpTargetUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.rdp"),
+					resource.TestCheckResourceAttr("boundary_target.rdp", DescriptionKey, fooTargetDescriptionUpdate),
+					resource.TestCheckResourceAttr("boundary_target.rdp", targetDefaultPortKey, "3390"),
+					resource.TestCheckResourceAttr("boundary_target.rdp", targetDefaultClientPortKey, "0"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.rdp", []string{"boundary_host_set.bar"}),
+				),
+			},
+			importStep("boundary_target.rdp"),
+		},
+	})
+}
+
+func TestAccTargetAddress(t *testing.T) {
+	skipForTestControllerVersion(t, "0.12.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fmt.Sprintf(fooAddressTarget, "127.0.0.1")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.address"),
+					resource.TestCheckResourceAttr("boundary_target.address", targetAddressKey, "127.0.0.1"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.address", nil),
+				),
+			},
+			importStep("boundary_target.address"),
+			{
+				// test update
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fmt.Sprintf(fooAddressTarget, "foo.example.com")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.address"),
+					resource.TestCheckResourceAttr("boundary_target.address", targetAddressKey, "foo.example.com"),
+				),
+			},
+			importStep("boundary_target.address"),
+			{
+				// test replacing the address with host sources
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooAddressTargetHostSources),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.address"),
+					resource.TestCheckResourceAttr("boundary_target.address", targetAddressKey, ""),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.address", []string{"boundary_host_set.foo"}),
+				),
+			},
+			importStep("boundary_target.address"),
+			{
+				// test replacing the host sources with an address
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fmt.Sprintf(fooAddressTarget, "127.0.0.1")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.address"),
+					resource.TestCheckResourceAttr("boundary_target.address", targetAddressKey, "127.0.0.1"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.address", nil),
+				),
+			},
+			importStep("boundary_target.address"),
+			{
+				// an address and host sources can't be used together
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooAddressTargetConflict),
+				ExpectError: regexp.MustCompile(`"address": conflicts with host_source_ids`),
+			},
+		},
+	})
+}
+
+func TestAccTargetWorkerFilters(t *testing.T) {
+	skipForTestControllerVersion(t, "0.12.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create with the deprecated worker filter
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooWorkerFilterTarget, `worker_filter = "\"foo\" in \"/tags/type\""`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.filtered"),
+					resource.TestCheckResourceAttr("boundary_target.filtered", targetWorkerFilterKey, `"foo" in "/tags/type"`),
+					resource.TestCheckResourceAttr("boundary_target.filtered", targetEgressWorkerFilterKey, ""),
+				),
+			},
+			importStep("boundary_target.filtered"),
+			{
+				// test migrating to the egress worker filter
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooWorkerFilterTarget, `egress_worker_filter = "\"foo\" in \"/tags/type\""`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.filtered"),
+					resource.TestCheckResourceAttr("boundary_target.filtered", targetWorkerFilterKey, ""),
+					resource.TestCheckResourceAttr("boundary_target.filtered", targetEgressWorkerFilterKey, `"foo" in "/tags/type"`),
+				),
+			},
+			importStep("boundary_target.filtered"),
+			{
+				// test update
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooWorkerFilterTarget, `egress_worker_filter = "\"bar\" in \"/tags/type\""`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.filtered"),
+					resource.TestCheckResourceAttr("boundary_target.filtered", targetEgressWorkerFilterKey, `"bar" in "/tags/type"`),
+				),
+			},
+			importStep("boundary_target.filtered"),
+			{
+				// the deprecated worker filter can't be combined with the new ones
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooWorkerFilterTarget, `
+	worker_filter        = "\"foo\" in \"/tags/type\""
+	egress_worker_filter = "\"bar\" in \"/tags/type\""`)),
+				ExpectError: regexp.MustCompile(`"worker_filter": conflicts with egress_worker_filter`),
+			},
+		},
+	})
+}
+
+func TestAccTargetWithAliases(t *testing.T) {
+	skipForTestControllerVersion(t, "0.16.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooTargetWithAliases, "bar.example.com")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.aliased"),
+					resource.TestCheckResourceAttr("boundary_target.aliased", targetWithAliasesKey+".#", "2"),
+					resource.TestCheckResourceAttr("boundary_target.aliased", targetWithAliasesKey+".0."+ScopeIdKey, "global"),
+					testAccCheckTargetResourceAliases(provider, "boundary_target.aliased", []string{"foo.example.com", "bar.example.com"}),
+				),
+			},
+			importStep("boundary_target.aliased", targetWithAliasesKey),
+			{
+				// test replacing one of the aliases
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooTargetWithAliases, "baz.example.com")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.aliased"),
+					resource.TestCheckResourceAttr("boundary_target.aliased", targetWithAliasesKey+".#", "2"),
+					testAccCheckTargetResourceAliases(provider, "boundary_target.aliased", []string{"foo.example.com", "baz.example.com"}),
+				),
+			},
+			{
+				// test removing the aliases
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetWithoutAliases),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.aliased"),
+					resource.TestCheckResourceAttr("boundary_target.aliased", targetWithAliasesKey+".#", "0"),
+					testAccCheckTargetResourceAliases(provider, "boundary_target.aliased", nil),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTargetResourceAliases checks that the aliases pointing to the
+// target in Boundary have exactly the given values.
+func testAccCheckTargetResourceAliases(testProvider *schema.Provider, name string, values []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("target resource not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("target resource ID is not set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		aClient := aliases.NewClient(md.client)
+
+		alr, err := aClient.List(context.Background(), "global")
+		if err != nil {
+			return fmt.Errorf("got an error when listing aliases: %w", err)
+		}
+
+		var got []string
+		for _, a := range alr.Items {
+			if a.DestinationId == id {
+				got = append(got, a.Value)
+			}
+		}
+		if len(got) != len(values) {
+			return fmt.Errorf("expected aliases %v pointing to the target, got %v", values, got)
+		}
+		for _, want := range values {
+			found := false
+			for _, g := range got {
+				if g == want {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("expected aliases %v pointing to the target, got %v", values, got)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTargetResourceHostSource(testProvider *schema.Provider, name string, hostSources []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -368,6 +821,63 @@ func testAccCheckTargetResourceBrokeredCredSources(testProvider *schema.Provider
 	}
 }
 
+func testAccCheckTargetResourceInjectedAppCredSources(testProvider *schema.Provider, name string, credSources []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("target resource not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("target resource ID is not set")
+		}
+
+		// ensure cred sources are declared in state
+		var credSourceIDs []string
+		for _, credSourceResourceID := range credSources {
+			cs, ok := s.RootModule().Resources[credSourceResourceID]
+			if !ok {
+				return fmt.Errorf("credential source resource not found: %s", credSourceResourceID)
+			}
+
+			credSourceID := cs.Primary.ID
+			if credSourceID == "" {
+				return fmt.Errorf("credential source resource ID not set")
+			}
+
+			credSourceIDs = append(credSourceIDs, credSourceID)
+		}
+
+		// check boundary to ensure it matches
+		md := testProvider.Meta().(*metaData)
+		tgtsClient := targets.NewClient(md.client)
+
+		t, err := tgtsClient.Read(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("got an error when reading target %q: %w", id, err)
+		}
+
+		if len(t.Item.InjectedApplicationCredentialSourceIds) != len(credSourceIDs) {
+			return fmt.Errorf("tf state and boundary have different number of injected application credential sources")
+		}
+
+		for _, stateCredSourceId := range t.Item.InjectedApplicationCredentialSourceIds {
+			ok := false
+			for _, gotCredSourceID := range credSourceIDs {
+				if gotCredSourceID == stateCredSourceId {
+					ok = true
+				}
+			}
+			if !ok {
+				return fmt.Errorf("injected application credential source id in state not set in boundary: %s", stateCredSourceId)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTargetResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -418,3 +928,289 @@ func testAccCheckTargetResourceDestroy(t *testing.T, testProvider *schema.Provid
 		return nil
 	}
 }
+
+func TestCustomizeDiffTargetSessionRecording(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "ssh",
+			raw: map[string]interface{}{
+				TypeKey:                         targetTypeSsh,
+				targetEnableSessionRecordingKey: true,
+				targetStorageBucketIdKey:        "sb_1234567890",
+			},
+		},
+		{
+			name: "disabled",
+			raw: map[string]interface{}{
+				TypeKey: targetTypeTcp,
+			},
+		},
+		{
+			name: "tcp",
+			raw: map[string]interface{}{
+				TypeKey:                         targetTypeTcp,
+				targetEnableSessionRecordingKey: true,
+				targetStorageBucketIdKey:        "sb_1234567890",
+			},
+			wantErr: `only supported on targets of type "ssh"`,
+		},
+		{
+			name: "no-storage-bucket",
+			raw: map[string]interface{}{
+				TypeKey:                         targetTypeSsh,
+				targetEnableSessionRecordingKey: true,
+			},
+			wantErr: `"storage_bucket_id" must be set`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.raw[ScopeIdKey] = "p_1234567890"
+			config := terraform.NewResourceConfigRaw(tt.raw)
+			_, err := resourceTarget().Diff(context.Background(), nil, config, &metaData{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceTargetCreateAddress(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/targets", &body, map[string]interface{}{
+		"id":       "ttcp_1234567890",
+		"scope_id": "p_1234567890",
+		"type":     targetTypeTcp,
+		"name":     "test",
+		"address":  "127.0.0.1",
+		"attributes": map[string]interface{}{
+			"default_port": 22,
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		NameKey:              "test",
+		TypeKey:              targetTypeTcp,
+		ScopeIdKey:           "p_1234567890",
+		targetDefaultPortKey: 22,
+		targetAddressKey:     "127.0.0.1",
+	})
+	require.False(t, resourceTargetCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, "127.0.0.1", body["address"])
+	assert.Equal(t, "ttcp_1234567890", d.Id())
+	assert.Equal(t, "127.0.0.1", d.Get(targetAddressKey))
+	assert.Equal(t, 22, d.Get(targetDefaultPortKey))
+}
+
+func TestRequireControllerVersionTargetAddress(t *testing.T) {
+	md := &metaData{controllerVersion: goversion.Must(goversion.NewVersion("0.11.2"))}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		TypeKey:          targetTypeTcp,
+		ScopeIdKey:       "p_1234567890",
+		targetAddressKey: "127.0.0.1",
+	})
+	_, err := resourceTarget().Diff(context.Background(), nil, config, md)
+	assert.ErrorContains(t, err, `The "address" of targets requires Boundary 0.12.0 or later`)
+
+	md.controllerVersion = goversion.Must(goversion.NewVersion("0.12.0"))
+	_, err = resourceTarget().Diff(context.Background(), nil, config, md)
+	assert.NoError(t, err)
+}
+
+func TestTargetAliasFromResponseMap(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{
+		IDKey:                          "alt_1234567890",
+		aliasValueKey:                  fooAliasValue,
+		ScopeIdKey:                     "global",
+		aliasAuthorizeSessionHostIdKey: "hst_1234567890",
+	}, targetAliasFromResponseMap(map[string]interface{}{
+		"id":       "alt_1234567890",
+		"scope_id": "global",
+		"value":    fooAliasValue,
+		"attributes": map[string]interface{}{
+			"authorize_session_arguments": map[string]interface{}{
+				"host_id": "hst_1234567890",
+			},
+		},
+	}))
+
+	// The controller leaves out the attributes when no host is set
+	assert.Nil(t, targetAliasFromResponseMap(map[string]interface{}{
+		"id":       "alt_1234567890",
+		"scope_id": "global",
+		"value":    fooAliasValue,
+	})[aliasAuthorizeSessionHostIdKey])
+}
+
+func TestUpdateTargetAliases(t *testing.T) {
+	var requests []string
+	md := testApiMetaData(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		alias := map[string]interface{}{
+			"scope_id":       "global",
+			"type":           aliasTypeTarget,
+			"destination_id": "ttcp_1234567890",
+			"version":        1,
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "DELETE /v1/aliases/alt_removed":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case "GET /v1/aliases/alt_updated":
+			alias["id"] = "alt_updated"
+			alias["value"] = fooAliasValue
+		case "PATCH /v1/aliases/alt_updated":
+			alias["id"] = "alt_updated"
+			alias["value"] = fooAliasValue
+			alias["version"] = 2
+			alias["attributes"] = map[string]interface{}{
+				"authorize_session_arguments": map[string]interface{}{
+					"host_id": "hst_1234567890",
+				},
+			}
+		case "POST /v1/aliases":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "ttcp_1234567890", body["destination_id"])
+			alias["id"] = "alt_created"
+			alias["value"] = body["value"]
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(alias))
+	})
+
+	old := []interface{}{
+		map[string]interface{}{
+			IDKey:         "alt_removed",
+			aliasValueKey: "removed.example.com",
+			ScopeIdKey:    "global",
+		},
+		map[string]interface{}{
+			IDKey:         "alt_updated",
+			aliasValueKey: fooAliasValue,
+			ScopeIdKey:    "global",
+		},
+	}
+	want := []interface{}{
+		map[string]interface{}{
+			aliasValueKey:                  fooAliasValue,
+			ScopeIdKey:                     "global",
+			aliasAuthorizeSessionHostIdKey: "hst_1234567890",
+		},
+		map[string]interface{}{
+			aliasValueKey: fooAliasValueUpdate,
+			ScopeIdKey:    "global",
+		},
+	}
+	got, err := updateTargetAliases(context.Background(), md, "ttcp_1234567890", old, want)
+	require.NoError(t, err)
+
+	// The alias no longer wanted is deleted first so its value can be reused
+	assert.Equal(t, []string{
+		"DELETE /v1/aliases/alt_removed",
+		"GET /v1/aliases/alt_updated",
+		"PATCH /v1/aliases/alt_updated",
+		"POST /v1/aliases",
+	}, requests)
+	require.Len(t, got, 2)
+	assert.Equal(t, "alt_updated", got[0].(map[string]interface{})[IDKey])
+	assert.Equal(t, "hst_1234567890", got[0].(map[string]interface{})[aliasAuthorizeSessionHostIdKey])
+	assert.Equal(t, "alt_created", got[1].(map[string]interface{})[IDKey])
+	assert.Equal(t, fooAliasValueUpdate, got[1].(map[string]interface{})[aliasValueKey])
+}
+
+func TestResourceTargetCreateWorkerFilters(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/targets", &body, map[string]interface{}{
+		"id":                    "ttcp_1234567890",
+		"scope_id":              "p_1234567890",
+		"type":                  targetTypeTcp,
+		"egress_worker_filter":  `"egress" in "/tags/type"`,
+		"ingress_worker_filter": `"ingress" in "/tags/type"`,
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		TypeKey:                      targetTypeTcp,
+		ScopeIdKey:                   "p_1234567890",
+		targetEgressWorkerFilterKey:  `"egress" in "/tags/type"`,
+		targetIngressWorkerFilterKey: `"ingress" in "/tags/type"`,
+	})
+	require.False(t, resourceTargetCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, `"egress" in "/tags/type"`, body["egress_worker_filter"])
+	assert.Equal(t, `"ingress" in "/tags/type"`, body["ingress_worker_filter"])
+	assert.NotContains(t, body, "worker_filter")
+	assert.Equal(t, `"egress" in "/tags/type"`, d.Get(targetEgressWorkerFilterKey))
+	assert.Equal(t, `"ingress" in "/tags/type"`, d.Get(targetIngressWorkerFilterKey))
+	assert.Equal(t, "", d.Get(targetWorkerFilterKey))
+}
+
+func TestRequireControllerVersionTargetWorkerFilters(t *testing.T) {
+	md := &metaData{controllerVersion: goversion.Must(goversion.NewVersion("0.11.2"))}
+	for _, key := range []string{targetEgressWorkerFilterKey, targetIngressWorkerFilterKey} {
+		t.Run(key, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				TypeKey:    targetTypeTcp,
+				ScopeIdKey: "p_1234567890",
+				key:        `"worker" in "/tags/type"`,
+			})
+			_, err := resourceTarget().Diff(context.Background(), nil, config, md)
+			assert.ErrorContains(t, err, `The "egress_worker_filter" and "ingress_worker_filter" of targets requires Boundary 0.12.0 or later`)
+		})
+	}
+}
+
+func TestResourceTargetCreateRdp(t *testing.T) {
+	var body map[string]interface{}
+	md := testApiMetaData(t, testApiItem(t, http.MethodPost, "/v1/targets", &body, map[string]interface{}{
+		"id":       "trdp_1234567890",
+		"scope_id": "p_1234567890",
+		"type":     targetTypeRdp,
+		"attributes": map[string]interface{}{
+			"default_port":        3389,
+			"default_client_port": 13389,
+		},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		TypeKey:                    targetTypeRdp,
+		ScopeIdKey:                 "p_1234567890",
+		targetDefaultPortKey:       3389,
+		targetDefaultClientPortKey: 13389,
+	})
+	require.False(t, resourceTargetCreate(context.Background(), d, md).HasError())
+
+	assert.Equal(t, targetTypeRdp, body["type"])
+	assert.Equal(t, map[string]interface{}{
+		"default_port":        float64(3389),
+		"default_client_port": float64(13389),
+	}, body["attributes"])
+	assert.Equal(t, "trdp_1234567890", d.Id())
+	assert.Equal(t, 3389, d.Get(targetDefaultPortKey))
+	assert.Equal(t, 13389, d.Get(targetDefaultClientPortKey))
+}
+
+func TestRequireControllerVersionTargetRdp(t *testing.T) {
+	md := &metaData{controllerVersion: goversion.Must(goversion.NewVersion("0.19.0"))}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		TypeKey:    targetTypeRdp,
+		ScopeIdKey: "p_1234567890",
+	})
+	_, err := resourceTarget().Diff(context.Background(), nil, config, md)
+	assert.ErrorContains(t, err, `Targets of type "rdp" requires Boundary 0.20.0 or later`)
+
+	md.controllerVersion = goversion.Must(goversion.NewVersion("0.20.0"))
+	_, err = resourceTarget().Diff(context.Background(), nil, config, md)
+	assert.NoError(t, err)
+}
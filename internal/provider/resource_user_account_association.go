@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	accountIdKey = "account_id"
+)
+
+func resourceUserAccountAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "The user account association resource associates a single account with an existing user, " +
+			"leaving the other accounts of the user alone. The `boundary_user` resource managing the user " +
+			"should not set `account_ids`, and should ignore changes to them with a `lifecycle` block.",
+
+		CreateContext: resourceUserAccountAssociationCreate,
+		ReadContext:   resourceUserAccountAssociationRead,
+		DeleteContext: resourceUserAccountAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			parentKey:        userIdKey,
+			parentCollection: "users",
+			parentAction:     "add-accounts",
+		}),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the association, in the form `<user_id>:<account_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			userIdKey: {
+				Description: "The ID of the user to associate the account with.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			accountIdKey: {
+				Description: "The ID of the account to associate with the user. An account can only be associated with a single user.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// parseUserAccountAssociationId splits the ID of a user account association
+// into the ID of the user and the account.
+func parseUserAccountAssociationId(id string) (string, string, error) {
+	userId, accountId, ok := strings.Cut(id, ":")
+	if !ok || userId == "" || accountId == "" {
+		return "", "", fmt.Errorf("invalid user account association ID %q, expected <user_id>:<account_id>", id)
+	}
+	return userId, accountId, nil
+}
+
+func resourceUserAccountAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	userId := d.Get(userIdKey).(string)
+	accountId := d.Get(accountIdKey).(string)
+	defer md.locks.lock(userId)()
+	_, err := usrs.AddAccounts(ctx, userId, 0, []string{accountId}, users.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error associating account with user: %v", err)
+	}
+
+	d.SetId(userId + ":" + accountId)
+	return nil
+}
+
+func resourceUserAccountAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	userId, accountId, err := parseUserAccountAssociationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	urr, err := usrs.Read(ctx, userId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read user: %v", err)
+	}
+	if urr == nil {
+		return diag.Errorf("user nil after read")
+	}
+
+	found := false
+	for _, a := range urr.Item.AccountIds {
+		if a == accountId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// The account was disassociated outside of Terraform, or deleted
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(userIdKey, userId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(accountIdKey, accountId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUserAccountAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	userId := d.Get(userIdKey).(string)
+	defer md.locks.lock(userId)()
+
+	_, err := usrs.RemoveAccounts(ctx, userId, 0, []string{d.Get(accountIdKey).(string)}, users.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error disassociating account from user: %v", err)
+	}
+
+	return nil
+}
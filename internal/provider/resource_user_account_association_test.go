@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	orgUserWithoutAccts = `
+resource "boundary_user" "org1" {
+	name        = "test"
+	description = "with accts"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+
+	lifecycle {
+		ignore_changes = [account_ids]
+	}
+}`
+
+	orgUserAccountAssociation = `
+resource "boundary_user_account_association" "foo" {
+	user_id    = boundary_user.org1.id
+	account_id = boundary_account.foo.id
+}`
+)
+
+func TestAccUserAccountAssociation(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckUserResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// associate the account
+				Config: testConfig(url, fooOrg, fooAccount, orgUserWithoutAccts, orgUserAccountAssociation),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserResourceAccountsSet(provider, "boundary_user.org1", []string{"boundary_account.foo"}),
+				),
+			},
+			importStep("boundary_user_account_association.foo"),
+			{
+				// disassociate it
+				Config: testConfig(url, fooOrg, fooAccount, orgUserWithoutAccts),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserResourceNoAccounts(provider, "boundary_user.org1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserResourceNoAccounts(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("user resource not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		usrClient := users.NewClient(md.client)
+
+		u, err := usrClient.Read(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading user %q: %v", rs.Primary.ID, err)
+		}
+		if len(u.Item.AccountIds) != 0 {
+			return fmt.Errorf("expected no accounts on user, got %v", u.Item.AccountIds)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	workerCaRotateTriggerKey = "rotate_trigger"
+	workerCaCertificatesKey  = "certificates"
+)
+
+func resourceWorkerCaRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "The worker CA rotation resource rotates the certificate authority used to authenticate " +
+			"workers. The CA is rotated when the resource is created and whenever `rotate_trigger` changes, " +
+			"e.g. from a `time_rotating` resource to rotate on a schedule. Destroying the resource leaves the " +
+			"CA as it is.",
+
+		CreateContext: resourceWorkerCaRotationRotate,
+		ReadContext:   resourceWorkerCaRotationRead,
+		UpdateContext: resourceWorkerCaRotationRotate,
+		DeleteContext: resourceWorkerCaRotationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts:      resourceTimeouts(),
+		CustomizeDiff: requireControllerVersion("0.12.0", "boundary_worker_ca_rotation", nil),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope the CA belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description: "The scope the worker CA belongs to. Defaults to `global`, the only scope workers can be created in.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "global",
+			},
+			workerCaRotateTriggerKey: {
+				Description: "An arbitrary value that causes the CA to be rotated whenever it changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			workerCaCertificatesKey: {
+				Description: "The certificates of the CA, the current one and the one that is being rotated in or out.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the certificate.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"public_key_sha256": {
+							Description: "The SHA-256 fingerprint of the public key of the certificate.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"not_before_time": {
+							Description: "The time the certificate becomes valid.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"not_after_time": {
+							Description: "The time the certificate expires.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setFromWorkerCaResponseMap(d *schema.ResourceData, scopeId string, raw map[string]interface{}) error {
+	d.SetId(scopeId)
+	d.Set(ScopeIdKey, scopeId)
+
+	var certs []interface{}
+	rawCerts, _ := raw["certs"].([]interface{})
+	for _, c := range rawCerts {
+		cert, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		certs = append(certs, map[string]interface{}{
+			IDKey:               cert["id"],
+			"public_key_sha256": cert["public_key_sha256"],
+			"not_before_time":   cert["not_before_time"],
+			"not_after_time":    cert["not_after_time"],
+		})
+	}
+	return d.Set(workerCaCertificatesKey, certs)
+}
+
+func resourceWorkerCaRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	wkrs := workers.NewClient(md.client)
+
+	scopeId := d.Id()
+	car, err := wkrs.ReadCA(ctx, scopeId)
+	if err != nil {
+		return diag.Errorf("error reading worker CA: %v", err)
+	}
+	if car == nil {
+		return diag.Errorf("worker CA nil after read")
+	}
+
+	if err := setFromWorkerCaResponseMap(d, scopeId, car.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWorkerCaRotationRotate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	wkrs := workers.NewClient(md.client)
+
+	// Only a change of the trigger rotates the CA, not a change of e.g. the
+	// timeouts
+	if d.Id() != "" && !d.HasChange(workerCaRotateTriggerKey) {
+		return resourceWorkerCaRotationRead(ctx, d, meta)
+	}
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	cau, err := wkrs.ReinitializeCA(ctx, scopeId)
+	if err != nil {
+		return diag.Errorf("error rotating worker CA: %v", err)
+	}
+	if cau == nil {
+		return diag.Errorf("worker CA nil after rotation")
+	}
+
+	if err := setFromWorkerCaResponseMap(d, scopeId, cau.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWorkerCaRotationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The CA can't be removed, forgetting about it is all there is to do
+	return nil
+}
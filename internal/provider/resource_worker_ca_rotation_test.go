@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func workerCaRotationConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "boundary_worker_ca_rotation" "global" {
+	rotate_trigger = "%s"
+}`, trigger)
+}
+
+func TestAccWorkerCaRotation(t *testing.T) {
+	skipForTestControllerVersion(t, "0.12.0")
+
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	var certIds []string
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, workerCaRotationConfig("1")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_worker_ca_rotation.global", "id", "global"),
+					resource.TestCheckResourceAttr("boundary_worker_ca_rotation.global", "scope_id", "global"),
+					testAccCollectWorkerCaCertIds("boundary_worker_ca_rotation.global", &certIds),
+				),
+			},
+			importStep("boundary_worker_ca_rotation.global", "rotate_trigger"),
+			{
+				// rotate
+				Config: testConfig(url, workerCaRotationConfig("2")),
+				Check: func(s *terraform.State) error {
+					before := certIds
+					if err := testAccCollectWorkerCaCertIds("boundary_worker_ca_rotation.global", &certIds)(s); err != nil {
+						return err
+					}
+					for _, id := range before {
+						if id == certIds[0] {
+							return fmt.Errorf("CA was not rotated, certificate %q is still current", id)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCollectWorkerCaCertIds(name string, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		*ids = nil
+		for i := 0; ; i++ {
+			id, ok := rs.Primary.Attributes[fmt.Sprintf("certificates.%d.id", i)]
+			if !ok {
+				break
+			}
+			*ids = append(*ids, id)
+		}
+		if len(*ids) == 0 {
+			return fmt.Errorf("No certificates found for %s", name)
+		}
+		return nil
+	}
+}
+
+func TestResourceWorkerCaRotationRotate(t *testing.T) {
+	var requests []string
+	md := testApiMetaData(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+	"certs": [
+		{"id": "roots_current", "public_key_sha256": "abc", "not_before_time": "2024-01-01T00:00:00Z", "not_after_time": "2024-02-01T00:00:00Z"},
+		{"id": "roots_next", "public_key_sha256": "def", "not_before_time": "2024-01-15T00:00:00Z", "not_after_time": "2024-03-01T00:00:00Z"}
+	]
+}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceWorkerCaRotation().Schema, map[string]interface{}{
+		workerCaRotateTriggerKey: "1",
+	})
+	require.False(t, resourceWorkerCaRotationRotate(context.Background(), d, md).HasError())
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, "POST /v1/workers:reinitialize-certificate-authority", requests[0])
+	assert.Equal(t, "global", d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{IDKey: "roots_current", "public_key_sha256": "abc", "not_before_time": "2024-01-01T00:00:00Z", "not_after_time": "2024-02-01T00:00:00Z"},
+		map[string]interface{}{IDKey: "roots_next", "public_key_sha256": "def", "not_before_time": "2024-01-15T00:00:00Z", "not_after_time": "2024-03-01T00:00:00Z"},
+	}, d.Get(workerCaCertificatesKey))
+}
+
+func TestRequireControllerVersionWorkerCaRotation(t *testing.T) {
+	md := &metaData{controllerVersion: goversion.Must(goversion.NewVersion("0.11.2"))}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{workerCaRotateTriggerKey: "1"})
+	_, err := resourceWorkerCaRotation().Diff(context.Background(), nil, config, md)
+	assert.ErrorContains(t, err, "boundary_worker_ca_rotation requires Boundary 0.12.0 or later")
+}
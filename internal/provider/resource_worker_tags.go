@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	workerIdKey        = "worker_id"
+	workerTagKey       = "tag"
+	workerTagKeyKey    = "key"
+	workerTagValuesKey = "values"
+)
+
+func resourceWorkerTags() *schema.Resource {
+	return &schema.Resource{
+		Description: "The worker tags resource allows you to manage the API tags of a worker, regardless of how " +
+			"the worker was registered. The resource takes ownership of all the API tags of the worker; tags " +
+			"added by other means are removed on the next apply.",
+
+		CreateContext: resourceWorkerTagsSet,
+		ReadContext:   resourceWorkerTagsRead,
+		UpdateContext: resourceWorkerTagsSet,
+		DeleteContext: resourceWorkerTagsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts:      resourceTimeouts(),
+		CustomizeDiff: requireControllerVersion("0.11.0", "boundary_worker_tags", nil),
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the worker.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			workerIdKey: {
+				Description: "The ID of the worker to manage the API tags of.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			workerTagKey: {
+				Description: "An API tag of the worker.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						workerTagKeyKey: {
+							Description: "The key of the tag.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						workerTagValuesKey: {
+							Description: "The values of the tag.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setFromWorkerTagsResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+	id := raw["id"].(string)
+	d.SetId(id)
+	d.Set(workerIdKey, id)
+
+	apiTags, _ := raw["api_tags"].(map[string]interface{})
+	keys := make([]string, 0, len(apiTags))
+	for k := range apiTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		values, _ := apiTags[k].([]interface{})
+		tags = append(tags, map[string]interface{}{
+			workerTagKeyKey:    k,
+			workerTagValuesKey: values,
+		})
+	}
+	return d.Set(workerTagKey, tags)
+}
+
+// expandWorkerTags returns the tags from a "tag" set in the form the API
+// expects. Tags that share a key are merged.
+func expandWorkerTags(set *schema.Set) map[string][]string {
+	apiTags := make(map[string][]string)
+	for _, t := range set.List() {
+		tag := t.(map[string]interface{})
+		key := tag[workerTagKeyKey].(string)
+		for _, v := range tag[workerTagValuesKey].(*schema.Set).List() {
+			apiTags[key] = append(apiTags[key], v.(string))
+		}
+	}
+	return apiTags
+}
+
+func resourceWorkerTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	wkrs := workers.NewClient(md.client)
+
+	wrr, err := wkrs.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read worker: %v", err)
+	}
+	if wrr == nil {
+		return diag.Errorf("worker nil after read")
+	}
+
+	if err := setFromWorkerTagsResponseMap(d, wrr.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWorkerTagsSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	wkrs := workers.NewClient(md.client)
+
+	apiTags := expandWorkerTags(d.Get(workerTagKey).(*schema.Set))
+	wur, err := wkrs.SetWorkerTags(ctx, d.Get(workerIdKey).(string), 0, apiTags, workers.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error setting worker tags: %v", err)
+	}
+	if wur == nil {
+		return diag.Errorf("worker nil after setting tags")
+	}
+
+	if err := setFromWorkerTagsResponseMap(d, wur.GetResponse().Map); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWorkerTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	wkrs := workers.NewClient(md.client)
+
+	apiTags := expandWorkerTags(d.Get(workerTagKey).(*schema.Set))
+	if len(apiTags) == 0 {
+		return nil
+	}
+	_, err := wkrs.RemoveWorkerTags(ctx, d.Id(), 0, apiTags, workers.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing worker tags: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	workerTagsCreate = `
+resource "boundary_worker" "controller_led" {
+	scope_id = "global"
+	name     = "tagged worker"
+}
+
+resource "boundary_worker_tags" "foo" {
+	worker_id = boundary_worker.controller_led.id
+	tag {
+		key    = "region"
+		values = ["us-east-1"]
+	}
+	tag {
+		key    = "type"
+		values = ["prod", "database"]
+	}
+}`
+
+	workerTagsUpdate = `
+resource "boundary_worker" "controller_led" {
+	scope_id = "global"
+	name     = "tagged worker"
+}
+
+resource "boundary_worker_tags" "foo" {
+	worker_id = boundary_worker.controller_led.id
+	tag {
+		key    = "region"
+		values = ["us-west-2"]
+	}
+}`
+)
+
+func TestAccWorkerTags(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckworkerResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: testConfig(url, workerTagsCreate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkerTags(provider, "boundary_worker_tags.foo", map[string][]string{
+						"region": {"us-east-1"},
+						"type":   {"database", "prod"},
+					}),
+					resource.TestCheckResourceAttr("boundary_worker_tags.foo", "tag.#", "2"),
+				),
+			},
+			importStep("boundary_worker_tags.foo"),
+			{
+				// update
+				Config: testConfig(url, workerTagsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkerTags(provider, "boundary_worker_tags.foo", map[string][]string{
+						"region": {"us-west-2"},
+					}),
+					resource.TestCheckResourceAttr("boundary_worker_tags.foo", "tag.#", "1"),
+				),
+			},
+			importStep("boundary_worker_tags.foo"),
+			{
+				// remove the tags but keep the worker
+				Config: testConfig(url, controllerLedCreate),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkerTags(testProvider *schema.Provider, name string, want map[string][]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		md := testProvider.Meta().(*metaData)
+		wkrClient := workers.NewClient(md.client)
+
+		wrr, err := wkrClient.Read(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading worker %q: %v", id, err)
+		}
+
+		got := wrr.GetItem().ApiTags
+		if len(got) != len(want) {
+			return fmt.Errorf("Expected tags %v, got %v", want, got)
+		}
+		for k, values := range want {
+			gotValues := append([]string(nil), got[k]...)
+			sort.Strings(gotValues)
+			if !reflect.DeepEqual(values, gotValues) {
+				return fmt.Errorf("Expected tags %v, got %v", want, got)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTimeout is used for every operation unless it is overridden in the
+// timeouts block of a resource.
+const defaultTimeout = 20 * time.Minute
+
+// resourceTimeouts returns the timeouts shared by all resources. The context
+// given to each CRUD function is bounded by the configured value, so requests
+// to a slow controller are only abandoned once it is exceeded.
+func resourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create:  schema.DefaultTimeout(defaultTimeout),
+		Read:    schema.DefaultTimeout(defaultTimeout),
+		Update:  schema.DefaultTimeout(defaultTimeout),
+		Delete:  schema.DefaultTimeout(defaultTimeout),
+		Default: schema.DefaultTimeout(defaultTimeout),
+	}
+}
+
+// dataSourceTimeouts returns the timeouts shared by all data sources, which
+// only ever read.
+func dataSourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Read:    schema.DefaultTimeout(defaultTimeout),
+		Default: schema.DefaultTimeout(defaultTimeout),
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+// configureTLS applies the TLS settings from the provider configuration to
+// the transport used by the API client. Settings that are not configured are
+// left as they are, which preserves anything picked up from the environment
+// when the default API config was built.
+func configureTLS(d *schema.ResourceData, transport *http.Transport) error {
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	tlsConfig := transport.TLSClientConfig
+
+	caCert, caCertOk := d.GetOk("ca_cert")
+	caPath, caPathOk := d.GetOk("ca_path")
+	if caCertOk || caPathOk {
+		pool := x509.NewCertPool()
+		if caCertOk {
+			pem, _, err := ReadPathOrContents(caCert.(string))
+			if err != nil {
+				return fmt.Errorf(`error reading "ca_cert": %w`, err)
+			}
+			if !pool.AppendCertsFromPEM([]byte(pem)) {
+				return errors.New(`no valid PEM-encoded certificates found in "ca_cert"`)
+			}
+		}
+		if caPathOk {
+			if err := appendCertsFromDir(pool, caPath.(string)); err != nil {
+				return fmt.Errorf(`error reading "ca_path": %w`, err)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, clientCertOk := d.GetOk("client_cert")
+	clientKey, clientKeyOk := d.GetOk("client_key")
+	if clientCertOk || clientKeyOk {
+		if !clientCertOk || !clientKeyOk {
+			return errors.New(`both "client_cert" and "client_key" must be set to use a client certificate`)
+		}
+		certPem, _, err := ReadPathOrContents(clientCert.(string))
+		if err != nil {
+			return fmt.Errorf(`error reading "client_cert": %w`, err)
+		}
+		keyPem, _, err := ReadPathOrContents(clientKey.(string))
+		if err != nil {
+			return fmt.Errorf(`error reading "client_key": %w`, err)
+		}
+		cert, err := tls.X509KeyPair([]byte(certPem), []byte(keyPem))
+		if err != nil {
+			return fmt.Errorf(`error parsing "client_cert" and "client_key": %w`, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+		// Make sure a certificate set from the environment doesn't take
+		// precedence over the one configured here
+		tlsConfig.GetClientCertificate = nil
+	}
+
+	if serverName, ok := d.GetOk("tls_server_name"); ok {
+		tlsConfig.ServerName = serverName.(string)
+	}
+
+	if tlsInsecure, ok := d.GetOk("tls_insecure"); ok {
+		tlsConfig.InsecureSkipVerify = tlsInsecure.(bool)
+	}
+
+	return nil
+}
+
+// appendCertsFromDir adds the certificates from every PEM file in dir to pool.
+func appendCertsFromDir(pool *x509.CertPool, dir string) error {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var found bool
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		pem, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if pool.AppendCertsFromPEM(pem) {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("no valid PEM-encoded certificates found in %q", dir)
+	}
+	return nil
+}
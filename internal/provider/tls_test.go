@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	caDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(caDir, "ca.pem"), []byte(caPem), 0o600))
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "system-trust-store",
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name: "ca-cert-contents",
+			raw:  map[string]interface{}{"ca_cert": caPem},
+		},
+		{
+			name: "ca-cert-path",
+			raw:  map[string]interface{}{"ca_cert": filepath.Join(caDir, "ca.pem")},
+		},
+		{
+			name: "ca-path",
+			raw:  map[string]interface{}{"ca_path": caDir},
+		},
+		{
+			name:    "wrong-server-name",
+			raw:     map[string]interface{}{"ca_cert": caPem, "tls_server_name": "not-the-server.local"},
+			wantErr: true,
+		},
+		{
+			name: "insecure",
+			raw:  map[string]interface{}{"tls_insecure": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, New().Schema, tt.raw)
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			require.NoError(t, configureTLS(d, transport))
+
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			resp.Body.Close()
+		})
+	}
+}
+
+// testClientCert returns a self-signed client certificate and its key, both
+// PEM-encoded.
+func testClientCert(t *testing.T, commonName string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestConfigureTLSClientCert(t *testing.T) {
+	certPem, keyPem := testClientCert(t, "terraform")
+	otherCertPem, otherKeyPem := testClientCert(t, "someone-else")
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM([]byte(certPem)))
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	defer srv.Close()
+
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.pem"), []byte(certPem), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.key"), []byte(keyPem), 0o600))
+
+	tests := []struct {
+		name          string
+		raw           map[string]interface{}
+		wantConfigErr string
+		wantErr       bool
+	}{
+		{
+			name:    "no-client-cert",
+			raw:     map[string]interface{}{"ca_cert": caPem},
+			wantErr: true,
+		},
+		{
+			name: "client-cert-contents",
+			raw:  map[string]interface{}{"ca_cert": caPem, "client_cert": certPem, "client_key": keyPem},
+		},
+		{
+			name: "client-cert-path",
+			raw: map[string]interface{}{
+				"ca_cert":     caPem,
+				"client_cert": filepath.Join(dir, "client.pem"),
+				"client_key":  filepath.Join(dir, "client.key"),
+			},
+		},
+		{
+			name:    "untrusted-client-cert",
+			raw:     map[string]interface{}{"ca_cert": caPem, "client_cert": otherCertPem, "client_key": otherKeyPem},
+			wantErr: true,
+		},
+		{
+			name:          "missing-key",
+			raw:           map[string]interface{}{"ca_cert": caPem, "client_cert": certPem},
+			wantConfigErr: `both "client_cert" and "client_key" must be set`,
+		},
+		{
+			name:          "missing-cert",
+			raw:           map[string]interface{}{"ca_cert": caPem, "client_key": keyPem},
+			wantConfigErr: `both "client_cert" and "client_key" must be set`,
+		},
+		{
+			name:          "mismatched-key",
+			raw:           map[string]interface{}{"ca_cert": caPem, "client_cert": certPem, "client_key": otherKeyPem},
+			wantConfigErr: `error parsing "client_cert" and "client_key"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BOUNDARY_CLIENT_CERT", "")
+			t.Setenv("BOUNDARY_CLIENT_KEY", "")
+			d := schema.TestResourceDataRaw(t, New().Schema, tt.raw)
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			err := configureTLS(d, transport)
+			if tt.wantConfigErr != "" {
+				assert.ErrorContains(t, err, tt.wantConfigErr)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			resp.Body.Close()
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// providerTransport wraps the transport of the API client's HTTP client. When
+// a request is rejected with a 401 and the provider knows how to obtain a new
+// token, the token is refreshed and the request is retried once with it. A
+// token that is known to expire soon is refreshed before the request is sent.
+//
+// The transport is also where the provider logs in to its auth method, right
+// before the first request that goes out to the controller.
+type providerTransport struct {
+	wrapped http.RoundTripper
+
+	// md is set once the provider metadata has been built, since the metadata
+	// holds the client that uses this transport.
+	md *metaData
+}
+
+func newProviderTransport(wrapped http.RoundTripper) *providerTransport {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &providerTransport{wrapped: wrapped}
+}
+
+func (t *providerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.md == nil || isAuthenticateRequest(req) {
+		return t.wrapped.RoundTrip(req)
+	}
+
+	if err := t.md.ensureAuthenticated(req.Context()); err != nil {
+		return nil, err
+	}
+	// The request was built before the login happened
+	if token := t.md.client.Token(); token != "" && req.Header.Get("Authorization") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if !t.md.canRefreshToken() {
+		return t.wrapped.RoundTrip(req)
+	}
+
+	if t.md.tokenNeedsRenewal() {
+		staleToken := bearerToken(req)
+		token, err := t.md.refreshToken(req.Context(), staleToken)
+		if err == nil && token != "" && token != staleToken {
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+
+	// The body has to be buffered so it can be sent a second time if the
+	// token needs to be refreshed.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.wrapped.RoundTrip(withBody(req, body))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	staleToken := bearerToken(req)
+	token, err := t.md.refreshToken(req.Context(), staleToken)
+	if err != nil || token == "" || token == staleToken {
+		// Hand back the original 401 so the caller gets the server's error
+		return resp, nil
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	retry := withBody(req, body)
+	retry.Header.Set("Authorization", "Bearer "+token)
+	return t.wrapped.RoundTrip(retry)
+}
+
+// isAuthenticateRequest reports whether req is a login to an auth method,
+// which must never trigger a token refresh itself.
+func isAuthenticateRequest(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, ":authenticate")
+}
+
+func bearerToken(req *http.Request) string {
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+}
+
+// withBody returns a clone of req with a fresh reader over body.
+func withBody(req *http.Request, body []byte) *http.Request {
+	r := req.Clone(req.Context())
+	if body != nil {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+	}
+	return r
+}
+
+// configureProxy sets up the transport to send requests through the proxy
+// from the provider configuration. When no proxy is configured the transport
+// keeps using the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func configureProxy(d *schema.ResourceData, transport *http.Transport) error {
+	proxyUrl, ok := d.GetOk("proxy_url")
+	if !ok {
+		return nil
+	}
+	u, err := url.Parse(proxyUrl.(string))
+	if err != nil {
+		return fmt.Errorf(`error parsing "proxy_url": %w`, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf(`"proxy_url" must use one of the http, https or socks5 schemes, got %q`, u.Scheme)
+	}
+
+	var noProxy []string
+	if v, ok := d.GetOk("no_proxy"); ok {
+		for _, host := range v.([]interface{}) {
+			noProxy = append(noProxy, host.(string))
+		}
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  u.String(),
+		HTTPSProxy: u.String(),
+		NoProxy:    strings.Join(noProxy, ","),
+	}).ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderTransportTokenFile(t *testing.T) {
+	const (
+		oldToken = "at_old"
+		newToken = "at_new"
+		reqBody  = `{"name":"foo"}`
+	)
+
+	var gotBodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBodies = append(gotBodies, string(body))
+		if r.Header.Get("Authorization") != "Bearer "+newToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(oldToken+"\n"), 0o600))
+
+	client, err := api.NewClient(nil)
+	require.NoError(t, err)
+	client.SetToken(oldToken)
+
+	pt := newProviderTransport(http.DefaultTransport)
+	pt.md = &metaData{client: client, tokenFile: tokenFile}
+	httpClient := &http.Client{Transport: pt}
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(reqBody))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+client.Token())
+		return req
+	}
+
+	// The token on disk hasn't changed, so the 401 is handed back
+	resp, err := httpClient.Do(newRequest())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, oldToken, client.Token())
+
+	// Once the token on disk is rotated the request is retried with it
+	require.NoError(t, os.WriteFile(tokenFile, []byte(newToken+"\n"), 0o600))
+	gotBodies = nil
+	resp, err = httpClient.Do(newRequest())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, newToken, client.Token())
+	assert.Equal(t, []string{reqBody, reqBody}, gotBodies)
+}
+
+func TestProviderTransportAuthenticate(t *testing.T) {
+	const authMethodId = "ampw_1234567890"
+
+	var logins int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth-methods/"+authMethodId+":authenticate" {
+			n := atomic.AddInt32(&logins, 1)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"command": "login",
+				"attributes": map[string]interface{}{
+					"token":           fmt.Sprintf("at_%d", n),
+					"expiration_time": time.Now().Add(time.Hour).Format(time.RFC3339Nano),
+				},
+			})
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth == "" || auth == "Bearer at_expired" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	pt := newProviderTransport(http.DefaultTransport)
+	config, err := api.DefaultConfig()
+	require.NoError(t, err)
+	config.HttpClient = &http.Client{Transport: pt}
+	config.Addr = srv.URL
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	md := &metaData{
+		client:       client,
+		authMethodId: authMethodId,
+		credentials:  map[string]interface{}{"login_name": "user", "password": "pass"},
+		authPending:  true,
+	}
+	pt.md = md
+	assert.Equal(t, int32(0), atomic.LoadInt32(&logins))
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/scopes", nil)
+		require.NoError(t, err)
+		if token := client.Token(); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req
+	}
+
+	// The login is deferred until the first request
+	resp, err := config.HttpClient.Do(newRequest())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "at_1", client.Token())
+
+	// A rejected token is replaced by authenticating again
+	client.SetToken("at_expired")
+	resp, err = config.HttpClient.Do(newRequest())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "at_2", client.Token())
+
+	// A token about to expire is replaced before the request is sent
+	md.tokenExpiry = time.Now().Add(time.Second)
+	resp, err = config.HttpClient.Do(newRequest())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "at_3", client.Token())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&logins))
+}
+
+func TestConfigureProxy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"proxy_url": "http://proxy.example.com:3128",
+		"no_proxy":  []interface{}{"direct.example.com", "10.0.0.0/8"},
+	})
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	require.NoError(t, configureProxy(d, transport))
+
+	proxyFor := func(target string) string {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		require.NoError(t, err)
+		u, err := transport.Proxy(req)
+		require.NoError(t, err)
+		if u == nil {
+			return ""
+		}
+		return u.String()
+	}
+	assert.Equal(t, "http://proxy.example.com:3128", proxyFor("https://boundary.example.com:9200/v1/scopes"))
+	assert.Equal(t, "", proxyFor("https://direct.example.com:9200/v1/scopes"))
+	assert.Equal(t, "", proxyFor("https://10.1.2.3:9200/v1/scopes"))
+
+	d = schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"proxy_url": "ftp://proxy.example.com",
+	})
+	assert.Error(t, configureProxy(d, transport))
+}
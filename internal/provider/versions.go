@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateControllerVersion checks that "controller_version" is a valid
+// version number.
+func validateControllerVersion(i interface{}, k string) ([]string, []error) {
+	if _, err := goversion.NewVersion(i.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a version number such as 0.11.2: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// versionProbes are API collections added by a Boundary release, in the order
+// of the releases. A controller answering a list of one of them with a 404 is
+// older than that release.
+var versionProbes = []struct {
+	version    string
+	collection string
+}{
+	{version: "0.13.0", collection: "storage-buckets"},
+	{version: "0.15.0", collection: "policies"},
+	{version: "0.16.0", collection: "aliases"},
+}
+
+// detectControllerVersion finds out which of the probed releases the
+// controller is older than. The API does not report the version the controller
+// runs, so only the collections it serves tell its version apart. The returned
+// version is the oldest release the controller is known to predate, nil when
+// it serves all probed collections.
+func (md *metaData) detectControllerVersion(ctx context.Context) (*goversion.Version, error) {
+	for _, p := range versionProbes {
+		req, err := md.client.NewRequest(ctx, http.MethodGet, p.collection, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := url.Values{}
+		q.Add("scope_id", "global")
+		req.URL.RawQuery = q.Encode()
+		resp, err := md.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return goversion.NewVersion(p.version)
+		}
+	}
+	return nil, nil
+}
+
+// requireControllerVersion returns a CustomizeDiffFunc failing the plan when
+// a resource uses a feature the controller is known to be too old for. uses
+// reports whether the planned resource relies on the feature, a nil uses means
+// the whole resource does.
+//
+// The version is taken from the provider's "controller_version" when set.
+// Otherwise the plan only fails when the version detected while configuring
+// the provider is certainly too old, and it is up to the controller to reject
+// requests for features newer than the last probed release.
+func requireControllerVersion(minVersion, feature string, uses func(*schema.ResourceDiff) bool) schema.CustomizeDiffFunc {
+	required := goversion.Must(goversion.NewVersion(minVersion))
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		md, ok := meta.(*metaData)
+		if !ok || md == nil || (md.controllerVersion == nil && md.controllerVersionBelow == nil) {
+			return nil
+		}
+		if uses != nil && !uses(d) {
+			return nil
+		}
+		if md.controllerVersion != nil {
+			if md.controllerVersion.LessThan(required) {
+				return fmt.Errorf("%s requires Boundary %s or later, but the controller runs %s", feature, required, md.controllerVersion)
+			}
+			return nil
+		}
+		if !required.LessThan(md.controllerVersionBelow) {
+			return fmt.Errorf("%s requires Boundary %s or later, but the controller is older than %s", feature, required, md.controllerVersionBelow)
+		}
+		return nil
+	}
+}
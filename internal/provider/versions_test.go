@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	boundaryversion "github.com/hashicorp/boundary/version"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireControllerVersion(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		credentialStoreIdKey:    "csst_1234567890",
+		credentialJsonObjectKey: `{"username":"admin"}`,
+	})
+
+	tests := []struct {
+		name    string
+		version string
+		below   string
+		wantErr string
+	}{
+		{name: "unknown"},
+		{name: "too-old", version: "0.10.5", wantErr: "boundary_credential_json requires Boundary 0.11.0 or later, but the controller runs 0.10.5"},
+		{name: "minimum", version: "0.11.0"},
+		{name: "newer", version: "0.12.1"},
+		{name: "detected-too-old", below: "0.11.0", wantErr: "boundary_credential_json requires Boundary 0.11.0 or later, but the controller is older than 0.11.0"},
+		{name: "detected-newer", below: "0.13.0"},
+		{name: "version-overrides-detected", version: "0.11.0", below: "0.11.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := &metaData{}
+			if tt.version != "" {
+				md.controllerVersion = goversion.Must(goversion.NewVersion(tt.version))
+			}
+			if tt.below != "" {
+				md.controllerVersionBelow = goversion.Must(goversion.NewVersion(tt.below))
+			}
+			_, err := resourceCredentialJson().Diff(context.Background(), nil, config, md)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDetectControllerVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		collections []string
+		want        string
+	}{
+		{name: "before-0.13", collections: []string{"scopes"}, want: "0.13.0"},
+		{name: "0.13", collections: []string{"scopes", "storage-buckets"}, want: "0.15.0"},
+		{name: "0.15", collections: []string{"scopes", "storage-buckets", "policies"}, want: "0.16.0"},
+		{name: "0.16-or-later", collections: []string{"scopes", "storage-buckets", "policies", "aliases"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "global", r.URL.Query().Get("scope_id"))
+				for _, c := range tt.collections {
+					if strings.TrimPrefix(r.URL.Path, "/v1/") == c {
+						w.Header().Set("Content-Type", "application/json")
+						w.Write([]byte(`{"items":[]}`))
+						return
+					}
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer srv.Close()
+
+			config, err := api.DefaultConfig()
+			require.NoError(t, err)
+			config.Addr = srv.URL
+			client, err := api.NewClient(config)
+			require.NoError(t, err)
+
+			got, err := (&metaData{client: client}).detectControllerVersion(context.Background())
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+// skipForTestControllerVersion skips an acceptance test needing a newer
+// Boundary than the test controller built from github.com/hashicorp/boundary,
+// so the test runs again once that module is bumped.
+func skipForTestControllerVersion(t *testing.T, minVersion string) {
+	t.Helper()
+	tcVersion := goversion.Must(goversion.NewVersion(boundaryversion.Get().Version))
+	if tcVersion.LessThan(goversion.Must(goversion.NewVersion(minVersion))) {
+		t.Skipf("Not running test against the %s test controller, it needs Boundary %s or later", tcVersion, minVersion)
+	}
+}
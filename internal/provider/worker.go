@@ -16,7 +16,6 @@ import (
 const (
 	scope                              = "scope"
 	scopeId                            = "global"
-	version                            = "version"
 	address                            = "address"
 	canonicalTags                      = "canonical_tags"
 	configTags                         = "config_tags"
@@ -38,6 +37,18 @@ func resourceWorker() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
+		CustomizeDiff: checkPermissions(permissionCheck{
+			collection:       "workers",
+			parentKey:        ScopeIdKey,
+			parentCollection: "scopes",
+			createAction: func(d *schema.ResourceDiff) string {
+				if d.Get(workerGeneratedAuthToken).(string) != "" {
+					return "create:worker-led"
+				}
+				return "create:controller-led"
+			},
+		}),
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -46,9 +57,10 @@ func resourceWorker() *schema.Resource {
 				Computed:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for the worker.",
+				Description: "The scope for the worker. Workers can only be created in the global scope.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			NameKey: {
 				Description: "The name for the worker.",
@@ -66,18 +78,21 @@ func resourceWorker() *schema.Resource {
 				Computed:    true,
 			},
 			workerGeneratedAuthToken: {
-				Description: "The worker authentication token required to register the worker for the worker-led authentication flow. Leaving this blank will result in a controller generated token.",
+				Description: "The worker authentication token required to register the worker for the worker-led authentication flow, as printed by the worker when it starts up without credentials. Leaving this blank will result in a controller generated token. Changing it registers a new worker.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
 			},
 			controllerGeneratedActivationToken: {
-				Description: "A single use token generated by the controller to be passed to the self-managed worker.",
+				Description: "A single use token generated by the controller to be passed to the self-managed worker, e.g. through cloud-init, so it can register itself. The token is only returned when the worker is created and is kept in the state afterwards.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 			},
 			releaseVersion: {
 				Description: "The version of the Boundary binary running on the self managed worker.",
-				Type:        schema.TypeInt,
+				Type:        schema.TypeString,
 				Computed:    true,
 			},
 			authorizedActions: {
@@ -98,8 +113,11 @@ func setFromWorkerResponseMap(d *schema.ResourceData, raw map[string]interface{}
 	d.Set(NameKey, raw["name"])
 	d.Set(DescriptionKey, raw["description"])
 	d.Set(address, raw["address"])
-	d.Set(workerGeneratedAuthToken, raw["worker_generated_auth_token"])
-	d.Set(controllerGeneratedActivationToken, raw["controller_generated_activation_token"])
+	// The auth token of a worker-led registration is never returned and the
+	// activation token only on creation, so both are kept from the state
+	if v, ok := raw["controller_generated_activation_token"]; ok {
+		d.Set(controllerGeneratedActivationToken, v)
+	}
 	d.Set(releaseVersion, raw["release_version"])
 	d.Set(authorizedActions, raw["authorized_actions"])
 
@@ -202,14 +220,9 @@ func resourceWorkerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	var versionInt int
-	if versionVal, ok := d.GetOk(version); ok {
-		versionInt = versionVal.(int)
-	}
-
 	if len(opts) > 0 {
 		opts = append(opts, workers.WithAutomaticVersioning(true))
-		_, err := wkr.Update(ctx, d.Id(), uint32(versionInt), opts...)
+		_, err := wkr.Update(ctx, d.Id(), 0, opts...)
 		if err != nil {
 			return diag.Errorf("error updating worker: %v", err)
 		}
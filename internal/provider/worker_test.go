@@ -81,7 +81,7 @@ func TestWorkerWorkerLed(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_worker.worker_led", "name", workerName),
 				),
 			},
-			importStep("boundary_worker.worker_led"),
+			importStep("boundary_worker.worker_led", "worker_generated_auth_token"),
 			{
 				// update
 				Config: testConfig(url, workerLedUpdate),
@@ -91,7 +91,7 @@ func TestWorkerWorkerLed(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_worker.worker_led", "name", workerNameUpdate),
 				),
 			},
-			importStep("boundary_worker.worker_led"),
+			importStep("boundary_worker.worker_led", "worker_generated_auth_token"),
 		},
 	})
 }
@@ -116,7 +116,7 @@ func TestWorkerControllerLed(t *testing.T) {
 					resource.TestCheckResourceAttrSet("boundary_worker.controller_led", "controller_generated_activation_token"),
 				),
 			},
-			importStep("boundary_worker.controller_led"),
+			importStep("boundary_worker.controller_led", "controller_generated_activation_token"),
 			{
 				// update
 				Config: testConfig(url, controllerLedUpdate),
@@ -126,7 +126,7 @@ func TestWorkerControllerLed(t *testing.T) {
 					resource.TestCheckResourceAttr("boundary_worker.controller_led", "name", workerNameUpdate),
 				),
 			},
-			importStep("boundary_worker.controller_led"),
+			importStep("boundary_worker.controller_led", "controller_generated_activation_token"),
 		},
 	})
 }
@@ -4,7 +4,11 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/hashicorp/terraform-provider-boundary/internal/provider"
 )
 
@@ -18,6 +22,28 @@ import (
 // can be customized.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
+// Version is set at build time.
+var Version = "dev"
+
 func main() {
-	plugin.Serve(&plugin.ServeOpts{ProviderFunc: provider.New})
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	provider.Version = Version
+
+	ctx := context.Background()
+	serverFactory, err := provider.NewMuxServerFactory(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	if err := tf6server.Serve("registry.terraform.io/hashicorp/boundary", serverFactory, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
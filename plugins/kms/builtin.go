@@ -5,13 +5,33 @@ package kms_plugin_assets
 
 import (
 	"github.com/hashicorp/go-kms-wrapping/v2/aead"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/awskms/v2"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/azurekeyvault/v2"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/gcpckms/v2"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/transit/v2"
 	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
 )
 
+// BuiltinKmsPlugins returns the KMS wrappers that are compiled into the
+// provider. They are available even when the provider is built without the
+// embedded plugin binaries, so recovery KMS configurations using one of the
+// major clouds or Vault Transit work in every build.
 func BuiltinKmsPlugins() map[string]pluginutil.InmemCreationFunc {
 	return map[string]pluginutil.InmemCreationFunc{
 		"aead": func() (interface{}, error) {
 			return aead.NewWrapper(), nil
 		},
+		"awskms": func() (interface{}, error) {
+			return awskms.NewWrapper(), nil
+		},
+		"azurekeyvault": func() (interface{}, error) {
+			return azurekeyvault.NewWrapper(), nil
+		},
+		"gcpckms": func() (interface{}, error) {
+			return gcpckms.NewWrapper(), nil
+		},
+		"transit": func() (interface{}, error) {
+			return transit.NewWrapper(), nil
+		},
 	}
 }